@@ -0,0 +1,3 @@
+data "snowflake_databases" "prod" {
+  like = "PROD_%"
+}
@@ -0,0 +1,29 @@
+# privileges granted on a database
+data "snowflake_grants" "on_database" {
+  grants_on {
+    object_type = "DATABASE"
+    object_name = "ANALYTICS"
+  }
+}
+
+# privileges and roles granted to a role
+data "snowflake_grants" "to_analyst" {
+  grants_to {
+    role = "ANALYST"
+  }
+}
+
+# users and roles the role has been granted to
+data "snowflake_grants" "of_analyst" {
+  grants_of {
+    role = "ANALYST"
+  }
+}
+
+# future grants in a schema
+data "snowflake_grants" "future" {
+  future_grants_in {
+    database = "ANALYTICS"
+    schema   = "PUBLIC"
+  }
+}
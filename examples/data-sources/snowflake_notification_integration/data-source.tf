@@ -0,0 +1,7 @@
+data "snowflake_notification_integration" "queue" {
+  name = "QUEUE_INTEGRATION"
+}
+
+output "consent_url" {
+  value = data.snowflake_notification_integration.queue.azure_consent_url
+}
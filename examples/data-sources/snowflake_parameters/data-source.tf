@@ -0,0 +1,10 @@
+data "snowflake_parameters" "timeouts" {
+  parameter_type = "ACCOUNT"
+  like           = "%TIMEOUT%"
+}
+
+data "snowflake_parameters" "db" {
+  parameter_type = "OBJECT"
+  object_type    = "DATABASE"
+  object_name    = "\"db\""
+}
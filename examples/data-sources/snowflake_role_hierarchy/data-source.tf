@@ -0,0 +1,4 @@
+data "snowflake_role_hierarchy" "analyst" {
+  role      = "ANALYST"
+  max_depth = 5
+}
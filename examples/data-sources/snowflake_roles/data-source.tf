@@ -0,0 +1,3 @@
+data "snowflake_roles" "analysts" {
+  like = "ANALYST_%"
+}
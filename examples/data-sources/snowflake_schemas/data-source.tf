@@ -0,0 +1,4 @@
+data "snowflake_schemas" "raw" {
+  database    = "ANALYTICS"
+  starts_with = "RAW_"
+}
@@ -0,0 +1,10 @@
+data "snowflake_stage_files" "landing" {
+  database = "db"
+  schema   = "schema"
+  stage    = "landing"
+  pattern  = ".*[.]csv"
+}
+
+output "landed" {
+  value = length(data.snowflake_stage_files.landing.files) > 0
+}
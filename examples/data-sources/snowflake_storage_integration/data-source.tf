@@ -0,0 +1,20 @@
+data "snowflake_storage_integration" "s3" {
+  name = "S3_INTEGRATION"
+}
+
+data "aws_iam_policy_document" "snowflake_trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "AWS"
+      identifiers = [data.snowflake_storage_integration.s3.storage_aws_iam_user_arn]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [data.snowflake_storage_integration.s3.storage_aws_external_id]
+    }
+  }
+}
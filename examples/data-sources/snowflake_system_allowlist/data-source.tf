@@ -0,0 +1,5 @@
+data "snowflake_system_allowlist" "current" {}
+
+output "snowflake_hosts" {
+  value = [for e in data.snowflake_system_allowlist.current.endpoints : "${e.host}:${e.port}"]
+}
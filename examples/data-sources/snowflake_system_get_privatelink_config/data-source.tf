@@ -0,0 +1,7 @@
+data "snowflake_system_get_privatelink_config" "snowflake_private_link" {}
+
+resource "aws_vpc_endpoint" "snowflake_private_link" {
+  vpc_id            = var.vpc_id
+  service_name      = data.snowflake_system_get_privatelink_config.snowflake_private_link.aws_vpce_id
+  vpc_endpoint_type = "Interface"
+}
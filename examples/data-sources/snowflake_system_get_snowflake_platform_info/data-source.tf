@@ -0,0 +1,19 @@
+data "snowflake_system_get_snowflake_platform_info" "current" {}
+
+data "aws_iam_policy_document" "bucket" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["${var.bucket_arn}/*"]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceVpc"
+      values   = data.snowflake_system_get_snowflake_platform_info.current.aws_vpc_ids
+    }
+  }
+}
@@ -0,0 +1,5 @@
+data "snowflake_system_pipe_status" "events" {
+  database = "db"
+  schema   = "schema"
+  name     = "events"
+}
@@ -0,0 +1 @@
+data "snowflake_system_whitelist" "current" {}
@@ -0,0 +1,5 @@
+data "snowflake_tables" "events" {
+  database = "ANALYTICS"
+  schema   = "RAW"
+  like     = "EVENTS_%"
+}
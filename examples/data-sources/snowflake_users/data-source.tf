@@ -0,0 +1,3 @@
+data "snowflake_users" "service_accounts" {
+  starts_with = "SVC_"
+}
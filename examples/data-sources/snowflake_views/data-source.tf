@@ -0,0 +1,4 @@
+data "snowflake_views" "reporting" {
+  database = "ANALYTICS"
+  schema   = "REPORTING"
+}
@@ -0,0 +1,3 @@
+data "snowflake_warehouses" "etl" {
+  like = "%ETL%"
+}
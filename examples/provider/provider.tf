@@ -11,4 +11,11 @@ provider snowflake {
 
   // optional
   role = "..."
+
+  // optional, connection pool tuning
+  max_open_conns            = 10
+  max_concurrent_statements = 8
+
+  // optional, refuse to destroy databases, schemas, tables and stages by default
+  deletion_protection = true
 }
@@ -0,0 +1,2 @@
+# format is the parameter key
+terraform import snowflake_account_parameter.timezone TIMEZONE
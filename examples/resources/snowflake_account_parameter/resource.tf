@@ -0,0 +1,9 @@
+resource "snowflake_account_parameter" "timezone" {
+  key   = "TIMEZONE"
+  value = "America/Los_Angeles"
+}
+
+resource "snowflake_account_parameter" "rekeying" {
+  key   = "PERIODIC_DATA_REKEYING"
+  value = "true"
+}
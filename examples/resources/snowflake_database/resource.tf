@@ -8,3 +8,12 @@ resource "snowflake_database" "test2" {
   name    = "testing_2"
   comment = "test comment 2"
 }
+
+resource "snowflake_database" "replicated" {
+  name = "replicated"
+
+  replication_configuration {
+    accounts        = ["MYORG.ACCOUNT2"]
+    enable_failover = true
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_failover_group.example name
@@ -0,0 +1,2 @@
+# format is object_type|database|schema|object_name|key
+terraform import snowflake_object_parameter.events_extension 'TABLE|db|schema|events|MAX_DATA_EXTENSION_TIME_IN_DAYS'
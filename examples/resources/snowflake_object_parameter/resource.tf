@@ -0,0 +1,19 @@
+resource "snowflake_object_parameter" "events_extension" {
+  object_type = "TABLE"
+  object_identifier {
+    database = "db"
+    schema   = "schema"
+    name     = "events"
+  }
+  key   = "MAX_DATA_EXTENSION_TIME_IN_DAYS"
+  value = "30"
+}
+
+resource "snowflake_object_parameter" "user_timezone" {
+  object_type = "USER"
+  object_identifier {
+    name = "jdoe"
+  }
+  key   = "TIMEZONE"
+  value = "Europe/Paris"
+}
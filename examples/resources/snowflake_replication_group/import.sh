@@ -0,0 +1 @@
+terraform import snowflake_replication_group.example name
@@ -0,0 +1,15 @@
+// in the primary account
+resource snowflake_replication_group primary {
+  name                      = "replication_group"
+  object_types              = ["DATABASES", "ROLES", "WAREHOUSES", "INTEGRATIONS"]
+  allowed_databases         = ["db1", "db2"]
+  allowed_integration_types = ["SECURITY INTEGRATIONS"]
+  allowed_accounts          = ["MYORG.ACCOUNT2"]
+  replication_schedule      = "10 MINUTE"
+}
+
+// in the secondary account
+resource snowflake_replication_group secondary {
+  name         = "replication_group"
+  from_replica = "MYORG.ACCOUNT1.replication_group"
+}
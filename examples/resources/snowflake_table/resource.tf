@@ -5,13 +5,61 @@ resource snowflake_table table {
   comment  = "A table."
   owner    = "me"
 
+  cluster_by          = ["to_date(created_at)"]
+  data_retention_days = 7
+  change_tracking     = true
+
   column {
-    name = "id"
-    type = "int"
+    name     = "id"
+    type     = "int"
+    nullable = false
+
+    identity {
+      start_num = 1
+      step_num  = 1
+    }
   }
 
   column {
-    name = "data"
-    type = "text"
+    name    = "data"
+    type    = "text"
+    comment = "The payload."
+  }
+
+  column {
+    name    = "created_at"
+    type    = "timestamp_ntz"
+    default = "CURRENT_TIMESTAMP()"
+  }
+
+  primary_key {
+    name = "pk_table"
+    keys = ["id"]
+  }
+
+  foreign_key {
+    name = "fk_table_parent"
+    keys = ["data"]
+
+    references {
+      database = "database"
+      schema   = "schmea"
+      table    = "parent"
+      keys     = ["data"]
+    }
+  }
+}
+
+resource snowflake_table dev_copy {
+  database   = "database"
+  schema     = "dev"
+  name       = "table"
+  table_type = "TRANSIENT"
+
+  clone_from {
+    database = "database"
+    schema   = "schmea"
+    table    = "table"
+    offset   = -3600
   }
 }
@@ -0,0 +1,90 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var currentAccountSchema = map[string]*schema.Schema{
+	"account": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The account locator of the account the provider is connected to.",
+	},
+	"region": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Snowflake region of the account, e.g. AWS_US_WEST_2.",
+	},
+	"role": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The role of the session.",
+	},
+	"user": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The user the provider is connected as.",
+	},
+	"url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the account, e.g. https://ab12345.us-east-2.aws.snowflakecomputing.com. Empty when it cannot be read from SYSTEM$ALLOWLIST.",
+	},
+}
+
+// CurrentAccount returns a pointer to the data source describing the session
+// the provider is connected with
+func CurrentAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadCurrentAccount,
+		Schema:      currentAccountSchema,
+	}
+}
+
+// ReadCurrentAccount implements schema.ReadContextFunc
+func ReadCurrentAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	row := snowflake.QueryRow(ctx, db, snowflake.SelectCurrentAccount())
+	account, err := snowflake.ScanCurrentAccount(row)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error reading current account"))
+	}
+	// the URL is only known from SYSTEM$ALLOWLIST, which not every role or
+	// account can call, so failing to read it leaves the URL empty rather than
+	// failing the whole data source
+	url := ""
+	row = snowflake.QueryRow(ctx, db, snowflake.SystemAllowlist().Select())
+	allowlist, err := snowflake.ScanAllowlist(row)
+	if err == nil {
+		url, err = account.URL(allowlist)
+	}
+	if err != nil {
+		log.Printf("[WARN] unable to read the URL of account %v: %v", account.Account, err)
+	}
+
+	d.SetId(account.Account)
+	if err := d.Set("account", account.Account); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("region", account.Region); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role", account.Role.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user", account.User); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", url); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
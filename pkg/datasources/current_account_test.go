@@ -0,0 +1,65 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentAccount(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.CurrentAccount().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadCurrentAccount(mock)
+		rows := sqlmock.NewRows([]string{"allowlist"}).
+			AddRow(`[{"type":"SNOWFLAKE_DEPLOYMENT","host":"ab12345.us-east-2.aws.snowflakecomputing.com","port":443}]`)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT SYSTEM$ALLOWLIST() AS "allowlist"`)).WillReturnRows(rows)
+
+		diags := datasources.ReadCurrentAccount(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("AB12345", d.Id())
+	r.Equal("AB12345", d.Get("account"))
+	r.Equal("AWS_US_EAST_2", d.Get("region"))
+	r.Equal("SYSADMIN", d.Get("role"))
+	r.Equal("TERRAFORM", d.Get("user"))
+	r.Equal("https://ab12345.us-east-2.aws.snowflakecomputing.com", d.Get("url"))
+}
+
+func TestCurrentAccountWithoutAllowlist(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.CurrentAccount().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadCurrentAccount(mock)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT SYSTEM$ALLOWLIST() AS "allowlist"`)).WillReturnError(errors.New("insufficient privileges"))
+
+		diags := datasources.ReadCurrentAccount(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("AB12345", d.Id())
+	r.Equal("AB12345", d.Get("account"))
+	r.Equal("TERRAFORM", d.Get("user"))
+	r.Equal("", d.Get("url"))
+}
+
+func expectReadCurrentAccount(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{"account", "region", "role", "user"}).
+		AddRow("AB12345", "AWS_US_EAST_2", "SYSADMIN", "TERRAFORM")
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT CURRENT_ACCOUNT() AS "account", CURRENT_REGION() AS "region", CURRENT_ROLE() AS "role", CURRENT_USER() AS "user"`)).WillReturnRows(rows)
+}
@@ -0,0 +1,82 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var databasesSchema = map[string]*schema.Schema{
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"databases": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The databases matching the filters.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Databases returns a pointer to the data source listing databases
+func Databases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadDatabases,
+		Schema:      databasesSchema,
+	}
+}
+
+// ReadDatabases implements schema.ReadContextFunc
+func ReadDatabases(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	stmt := withShowFilters(d, snowflake.Show("DATABASES")).Statement()
+
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing databases"))
+	}
+	defer rows.Close()
+
+	dbs, err := snowflake.ScanDatabases(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing databases"))
+	}
+
+	databases := make([]map[string]interface{}, 0, len(dbs))
+	for _, database := range dbs {
+		databases = append(databases, map[string]interface{}{
+			"name":       database.DBName.String,
+			"owner":      database.Owner.String,
+			"comment":    database.Comment.String,
+			"created_on": database.CreatedOn.String,
+		})
+	}
+
+	d.SetId(showID(d))
+	if err := d.Set("databases", databases); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
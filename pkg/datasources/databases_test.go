@@ -0,0 +1,38 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabases(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Databases().Schema, map[string]interface{}{"like": "prod%"})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time"}).
+			AddRow("2021-01-01 00:00:00", "PROD_RAW", "N", "N", "", "SYSADMIN", "raw data", "", "1").
+			AddRow("2021-01-02 00:00:00", "PROD_MART", "N", "N", "", "SYSADMIN", nil, "", "1")
+		mock.ExpectQuery(`^SHOW DATABASES LIKE 'prod%'$`).WillReturnRows(rows)
+
+		diags := datasources.ReadDatabases(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("prod%|", d.Id())
+	r.Equal(2, d.Get("databases.#"))
+	r.Equal("PROD_RAW", d.Get("databases.0.name"))
+	r.Equal("SYSADMIN", d.Get("databases.0.owner"))
+	r.Equal("raw data", d.Get("databases.0.comment"))
+	r.Equal("2021-01-01 00:00:00", d.Get("databases.0.created_on"))
+	r.Equal("", d.Get("databases.1.comment"))
+}
@@ -0,0 +1,45 @@
+package datasources
+
+import (
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var likeSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "Filters the objects by name using a case-insensitive SQL LIKE pattern, e.g. `%prod%`.",
+}
+
+var startsWithSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "Filters the objects by a case-sensitive name prefix.",
+}
+
+// withShowFilters applies the like and starts_with attributes of d, if set, to b.
+func withShowFilters(d *schema.ResourceData, b *snowflake.ShowBuilder) *snowflake.ShowBuilder {
+	if v, ok := d.GetOk("like"); ok {
+		b.Like(v.(string))
+	}
+	if v, ok := d.GetOk("starts_with"); ok {
+		b.StartsWith(v.(string))
+	}
+	return b
+}
+
+// showID builds a data source ID from its container and filters so that it is
+// stable across reads.
+func showID(d *schema.ResourceData, container ...string) string {
+	parts := append([]string{}, container...)
+	for _, key := range []string{"like", "starts_with"} {
+		if v, ok := d.GetOk(key); ok {
+			parts = append(parts, v.(string))
+		} else {
+			parts = append(parts, "")
+		}
+	}
+	return strings.Join(parts, "|")
+}
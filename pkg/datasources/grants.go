@@ -0,0 +1,243 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var grantsModes = []string{"grants_on", "grants_to", "grants_of", "future_grants_in"}
+
+var grantsSchema = map[string]*schema.Schema{
+	"grants_on": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all privileges that have been granted on the object.",
+		ExactlyOneOf: grantsModes,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The object type, e.g. ACCOUNT, DATABASE, SCHEMA, TABLE or WAREHOUSE.",
+				},
+				"object_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The fully qualified name of the object, e.g. db.schema.table. Each part is quoted, so it must match the case of the object. Required unless object_type is ACCOUNT.",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^."]+(\.[^."]+)*$`), "object_name must be the unquoted parts of the name separated by dots, e.g. db.schema.table"),
+				},
+			},
+		},
+	},
+	"grants_to": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all privileges and roles granted to the role.",
+		ExactlyOneOf: grantsModes,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	},
+	"grants_of": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all users and roles to which the role has been granted.",
+		ExactlyOneOf: grantsModes,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	},
+	"future_grants_in": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all future grants in the schema, or in the database if no schema is given.",
+		ExactlyOneOf: grantsModes,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	},
+	"grants": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The grants returned by Snowflake.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"privilege": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"granted_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"granted_to": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"grantee_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"grant_option": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"granted_by": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Grants returns a pointer to the data source listing grants on an object, to
+// or of a role, or future grants in a container
+func Grants() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadGrants,
+		Schema:      grantsSchema,
+	}
+}
+
+// ReadGrants implements schema.ReadContextFunc
+func ReadGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	var grants []map[string]interface{}
+	var id []string
+	var err error
+
+	if v, ok := d.GetOk("grants_on.0"); ok {
+		on := v.(map[string]interface{})
+		objectType, objectName := on["object_type"].(string), on["object_name"].(string)
+		if objectName == "" && !strings.EqualFold(objectType, "ACCOUNT") {
+			return diag.Errorf("grants_on requires object_name for object_type %v", objectType)
+		}
+		id = []string{"on", objectType, objectName}
+		grants, err = readCurrentGrants(ctx, db, snowflake.ShowGrantsOn(objectType, objectName), "")
+	} else if v, ok := d.GetOk("grants_to.0"); ok {
+		role := v.(map[string]interface{})["role"].(string)
+		id = []string{"to", role}
+		grants, err = readCurrentGrants(ctx, db, snowflake.ShowGrantsToRole(role), "")
+	} else if v, ok := d.GetOk("grants_of.0"); ok {
+		role := v.(map[string]interface{})["role"].(string)
+		id = []string{"of", role}
+		grants, err = readCurrentGrants(ctx, db, snowflake.ShowGrantsOfRole(role), role)
+	} else if v, ok := d.GetOk("future_grants_in.0"); ok {
+		in := v.(map[string]interface{})
+		database, schemaName := in["database"].(string), in["schema"].(string)
+		id = []string{"future", database, schemaName}
+		grants, err = readFutureGrants(ctx, db, snowflake.ShowFutureGrantsIn(database, schemaName))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join(id, "|"))
+	if err := d.Set("grants", grants); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// readCurrentGrants runs a SHOW GRANTS statement. SHOW GRANTS OF ROLE returns
+// the granted role in its own column instead of a privilege on a named object,
+// so ofRole is reported as USAGE on that role.
+func readCurrentGrants(ctx context.Context, db *sql.DB, stmt, ofRole string) ([]map[string]interface{}, error) {
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing grants")
+	}
+	defer rows.Close()
+
+	grants := []map[string]interface{}{}
+	for rows.Next() {
+		g := &snowflake.CurrentGrant{}
+		if err := rows.StructScan(g); err != nil {
+			return nil, errors.Wrap(err, "error listing grants")
+		}
+		if ofRole != "" {
+			g.Privilege = "USAGE"
+			g.GrantType = "ROLE"
+			g.GrantName = ofRole
+		}
+		grants = append(grants, map[string]interface{}{
+			"created_on":   g.CreatedOn.Format(time.RFC3339),
+			"privilege":    g.Privilege,
+			"granted_on":   g.GrantType,
+			"name":         g.GrantName,
+			"granted_to":   g.GranteeType,
+			"grantee_name": g.GranteeName,
+			"grant_option": g.GrantOption,
+			"granted_by":   g.GrantedBy,
+		})
+	}
+	return grants, rows.Err()
+}
+
+func readFutureGrants(ctx context.Context, db *sql.DB, stmt string) ([]map[string]interface{}, error) {
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing future grants")
+	}
+	defer rows.Close()
+
+	grants := []map[string]interface{}{}
+	for rows.Next() {
+		g := &snowflake.FutureGrant{}
+		if err := rows.StructScan(g); err != nil {
+			return nil, errors.Wrap(err, "error listing future grants")
+		}
+		grants = append(grants, map[string]interface{}{
+			"created_on":   g.CreatedOn.Format(time.RFC3339),
+			"privilege":    g.Privilege,
+			"granted_on":   g.GrantType,
+			"name":         g.GrantName,
+			"granted_to":   g.GranteeType,
+			"grantee_name": g.GranteeName,
+			"grant_option": g.GrantOption,
+		})
+	}
+	return grants, rows.Err()
+}
@@ -0,0 +1,122 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrants(t *testing.T) {
+	r := require.New(t)
+	err := datasources.Grants().InternalValidate(nil, false)
+	r.NoError(err)
+}
+
+func TestGrantsOn(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Grants().Schema, map[string]interface{}{
+		"grants_on": []interface{}{map[string]interface{}{
+			"object_type": "DATABASE",
+			"object_name": "test_db",
+		}},
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"}).
+			AddRow(time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC), "USAGE", "DATABASE", "TEST_DB", "ROLE", "ANALYST", true, "SYSADMIN").
+			AddRow(time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC), "OWNERSHIP", "DATABASE", "TEST_DB", "ROLE", "SYSADMIN", false, "SYSADMIN")
+		mock.ExpectQuery(`^SHOW GRANTS ON DATABASE "test_db"$`).WillReturnRows(rows)
+
+		diags := datasources.ReadGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("on|DATABASE|test_db", d.Id())
+	r.Equal(2, d.Get("grants.#"))
+	r.Equal("USAGE", d.Get("grants.0.privilege"))
+	r.Equal("DATABASE", d.Get("grants.0.granted_on"))
+	r.Equal("TEST_DB", d.Get("grants.0.name"))
+	r.Equal("ROLE", d.Get("grants.0.granted_to"))
+	r.Equal("ANALYST", d.Get("grants.0.grantee_name"))
+	r.True(d.Get("grants.0.grant_option").(bool))
+	r.Equal("2021-01-02T03:04:05Z", d.Get("grants.0.created_on"))
+}
+
+func TestGrantsOnWithoutObjectName(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Grants().Schema, map[string]interface{}{
+		"grants_on": []interface{}{map[string]interface{}{"object_type": "DATABASE"}},
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := datasources.ReadGrants(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Equal("grants_on requires object_name for object_type DATABASE", diags[0].Summary)
+	})
+
+	diags := datasources.Grants().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"grants_on": []interface{}{map[string]interface{}{"object_type": "TABLE", "object_name": `"db"."schema"."table"`}},
+	}))
+	r.True(diags.HasError())
+}
+
+func TestGrantsOfRole(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Grants().Schema, map[string]interface{}{
+		"grants_of": []interface{}{map[string]interface{}{"role": "analyst"}},
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}).
+			AddRow(time.Now(), "ANALYST", "USER", "ALICE", "SECURITYADMIN")
+		mock.ExpectQuery(`^SHOW GRANTS OF ROLE "analyst"$`).WillReturnRows(rows)
+
+		diags := datasources.ReadGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(1, d.Get("grants.#"))
+	r.Equal("USAGE", d.Get("grants.0.privilege"))
+	r.Equal("ROLE", d.Get("grants.0.granted_on"))
+	r.Equal("analyst", d.Get("grants.0.name"))
+	r.Equal("USER", d.Get("grants.0.granted_to"))
+	r.Equal("ALICE", d.Get("grants.0.grantee_name"))
+}
+
+func TestFutureGrantsIn(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Grants().Schema, map[string]interface{}{
+		"future_grants_in": []interface{}{map[string]interface{}{"database": "test_db", "schema": "PUBLIC"}},
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "privilege", "grant_on", "name", "grant_to", "grantee_name", "grant_option"}).
+			AddRow(time.Now(), "SELECT", "TABLE", "TEST_DB.PUBLIC.<TABLE>", "ROLE", "ANALYST", false)
+		mock.ExpectQuery(`^SHOW FUTURE GRANTS IN SCHEMA "test_db"."PUBLIC"$`).WillReturnRows(rows)
+
+		diags := datasources.ReadGrants(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("future|test_db|PUBLIC", d.Id())
+	r.Equal(1, d.Get("grants.#"))
+	r.Equal("SELECT", d.Get("grants.0.privilege"))
+	r.Equal("TABLE", d.Get("grants.0.granted_on"))
+	r.Equal("ANALYST", d.Get("grants.0.grantee_name"))
+}
@@ -0,0 +1,91 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// integrationProperty is a DESCRIBE INTEGRATION property exposed as its own
+// attribute, named after the property in lower case
+type integrationProperty struct {
+	name        string
+	description string
+	// list properties are comma separated
+	list bool
+}
+
+// integrationSchema returns the schema of an integration data source with an
+// attribute for each of properties
+func integrationSchema(properties []integrationProperty) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the integration.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the integration.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the integration is enabled.",
+		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time when the integration was created.",
+		},
+		"properties": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "Every property returned by DESCRIBE INTEGRATION, keyed by property name, including the ones without an attribute of their own.",
+		},
+	}
+	for _, p := range properties {
+		attr := &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: p.description,
+		}
+		if p.list {
+			attr.Type = schema.TypeList
+			attr.Elem = &schema.Schema{Type: schema.TypeString}
+		}
+		s[strings.ToLower(p.name)] = attr
+	}
+	return s
+}
+
+// readIntegrationProperties describes the integration and sets properties and
+// the properties map
+func readIntegrationProperties(ctx context.Context, d *schema.ResourceData, db *sql.DB, integration *snowflake.Builder, properties []integrationProperty) error {
+	described, err := snowflake.DescIntegration(ctx, db, integration.Describe())
+	if err != nil {
+		return errors.Wrap(err, "error describing integration")
+	}
+
+	for _, p := range properties {
+		v := described[p.name]
+		var value interface{} = v
+		if p.list {
+			values := []string{}
+			if v != "" {
+				values = strings.Split(v, ",")
+			}
+			value = values
+		}
+		if err := d.Set(strings.ToLower(p.name), value); err != nil {
+			return err
+		}
+	}
+	return d.Set("properties", described)
+}
@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var notificationIntegrationProperties = []integrationProperty{
+	{name: "NOTIFICATION_PROVIDER", description: "The messaging service, e.g. AZURE_STORAGE_QUEUE, AWS_SQS, AWS_SNS or GCP_PUBSUB."},
+	{name: "DIRECTION", description: "Whether the integration receives (INBOUND) or sends (OUTBOUND) notifications."},
+	{name: "AZURE_STORAGE_QUEUE_PRIMARY_URI", description: "The Azure storage queue Snowflake reads from."},
+	{name: "AZURE_TENANT_ID", description: "The Azure tenant of the storage queue."},
+	{name: "AZURE_CONSENT_URL", description: "The URL to visit to grant Snowflake access to the tenant."},
+	{name: "AZURE_MULTI_TENANT_APP_NAME", description: "The Snowflake client application created for the account, to grant access to the storage queue."},
+	{name: "AWS_SQS_ARN", description: "The AWS SQS queue Snowflake reads from."},
+	{name: "AWS_SQS_ROLE_ARN", description: "The AWS IAM role Snowflake assumes to read the queue."},
+	{name: "AWS_SQS_EXTERNAL_ID", description: "The external ID Snowflake passes when assuming the queue's role."},
+	{name: "AWS_SQS_IAM_USER_ARN", description: "The AWS IAM user Snowflake uses to assume the queue's role."},
+	{name: "AWS_SNS_TOPIC_ARN", description: "The AWS SNS topic Snowflake publishes to."},
+	{name: "AWS_SNS_ROLE_ARN", description: "The AWS IAM role Snowflake assumes to publish to the topic."},
+	{name: "SF_AWS_IAM_USER_ARN", description: "The AWS IAM user Snowflake uses to assume the topic's role, to trust in the role's trust policy."},
+	{name: "SF_AWS_EXTERNAL_ID", description: "The external ID Snowflake passes when assuming the topic's role, to require in the role's trust policy."},
+	{name: "GCP_PUBSUB_SUBSCRIPTION_NAME", description: "The Google Cloud Pub/Sub subscription Snowflake reads from."},
+	{name: "GCP_PUBSUB_SERVICE_ACCOUNT", description: "The Google Cloud service account Snowflake uses, to grant access to the subscription."},
+	{name: "COMMENT", description: "The comment of the integration."},
+}
+
+// NotificationIntegration returns a pointer to the data source describing a
+// notification integration
+func NotificationIntegration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadNotificationIntegration,
+		Schema:      integrationSchema(notificationIntegrationProperties),
+	}
+}
+
+// ReadNotificationIntegration implements schema.ReadContextFunc
+func ReadNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+	integration := snowflake.NotificationIntegration(name)
+
+	row := snowflake.QueryRow(ctx, db, integration.Show())
+	s, err := snowflake.ScanNotificationIntegration(row)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading notification integration %v", name))
+	}
+	if c := s.Category.String; c != "NOTIFICATION" {
+		return diag.FromErr(fmt.Errorf("expected %v to be a NOTIFICATION integration, got %v", name, c))
+	}
+
+	d.SetId(name)
+	if err := d.Set("type", s.IntegrationType.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := readIntegrationProperties(ctx, d, db, integration, notificationIntegrationProperties); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
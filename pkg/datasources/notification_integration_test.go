@@ -0,0 +1,46 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationIntegration(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.NotificationIntegration().Schema, map[string]interface{}{"name": "queue"})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		showRows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+			AddRow("QUEUE", "QUEUE - AZURE_STORAGE_QUEUE", "NOTIFICATION", true, nil, "2021-01-01 00:00:00")
+		mock.ExpectQuery(`^SHOW NOTIFICATION INTEGRATIONS LIKE 'queue'$`).WillReturnRows(showRows)
+
+		descRows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("ENABLED", "Boolean", "true", "false").
+			AddRow("NOTIFICATION_PROVIDER", "String", "AZURE_STORAGE_QUEUE", nil).
+			AddRow("AZURE_STORAGE_QUEUE_PRIMARY_URI", "String", "https://account.queue.core.windows.net/queue", nil).
+			AddRow("AZURE_TENANT_ID", "String", "tenant", nil).
+			AddRow("AZURE_CONSENT_URL", "String", "https://login.microsoftonline.com/tenant/oauth2/authorize", nil).
+			AddRow("AZURE_MULTI_TENANT_APP_NAME", "String", "app_1234", nil)
+		mock.ExpectQuery(`^DESCRIBE NOTIFICATION INTEGRATION "queue"$`).WillReturnRows(descRows)
+
+		diags := datasources.ReadNotificationIntegration(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("queue", d.Id())
+	r.Equal("AZURE_STORAGE_QUEUE", d.Get("notification_provider"))
+	r.Equal("https://account.queue.core.windows.net/queue", d.Get("azure_storage_queue_primary_uri"))
+	r.Equal("https://login.microsoftonline.com/tenant/oauth2/authorize", d.Get("azure_consent_url"))
+	r.Equal("app_1234", d.Get("azure_multi_tenant_app_name"))
+	r.Equal("", d.Get("aws_sqs_arn"))
+	r.Len(d.Get("properties"), 6)
+}
@@ -0,0 +1,130 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var parametersSchema = map[string]*schema.Schema{
+	"parameter_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "SESSION",
+		Description:  "The level to list the parameters of: ACCOUNT, SESSION or OBJECT.",
+		ValidateFunc: validation.StringInSlice([]string{"ACCOUNT", "SESSION", "OBJECT"}, false),
+	},
+	"object_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The type of the object to list the parameters of when parameter_type is OBJECT: DATABASE, SCHEMA, TABLE, TASK, USER or WAREHOUSE.",
+		ValidateFunc: validation.StringInSlice([]string{"DATABASE", "SCHEMA", "TABLE", "TASK", "USER", "WAREHOUSE"}, false),
+	},
+	"object_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The fully qualified name of the object when parameter_type is OBJECT, quoted where needed, e.g. `\"db\".\"schema\"`.",
+	},
+	"like": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the parameters by key using a case-insensitive SQL LIKE pattern, e.g. `%TIMEOUT%`.",
+	},
+	"parameters": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The parameters matching the filter.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"default": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"level": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Where the value is set, e.g. ACCOUNT, or empty if it is the default.",
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Parameters returns a pointer to the data source listing account, session or
+// object parameters
+func Parameters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadParameters,
+		Schema:      parametersSchema,
+	}
+}
+
+// ReadParameters implements schema.ReadContextFunc
+func ReadParameters(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	parameterType := d.Get("parameter_type").(string)
+	objectType := d.Get("object_type").(string)
+	objectName := d.Get("object_name").(string)
+
+	b := withShowFilters(d, snowflake.Show("PARAMETERS"))
+	switch parameterType {
+	case "ACCOUNT":
+		b.InAccount()
+	case "OBJECT":
+		if objectType == "" || objectName == "" {
+			return diag.FromErr(fmt.Errorf("object_type and object_name are required when parameter_type is OBJECT"))
+		}
+		b.In(objectType, objectName)
+	}
+
+	rows, err := snowflake.Query(ctx, db, b.Statement())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing parameters"))
+	}
+	defer rows.Close()
+
+	found, err := snowflake.ScanParameters(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing parameters"))
+	}
+
+	parameters := make([]map[string]interface{}, 0, len(found))
+	for _, p := range found {
+		parameters = append(parameters, map[string]interface{}{
+			"key":         p.Key,
+			"value":       p.Value,
+			"default":     p.DefaultValue,
+			"level":       p.Level,
+			"description": p.Description,
+			"type":        p.Type,
+		})
+	}
+
+	d.SetId(showID(d, parameterType, objectType, objectName))
+	if err := d.Set("parameters", parameters); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var parameterColumns = []string{"key", "value", "default", "level", "description", "type"}
+
+func TestParametersAccount(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Parameters().Schema, map[string]interface{}{
+		"parameter_type": "ACCOUNT",
+		"like":           "%TIMEOUT%",
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows(parameterColumns).
+			AddRow("STATEMENT_TIMEOUT_IN_SECONDS", "3600", "172800", "ACCOUNT", "Timeout in seconds for statements.", "NUMBER").
+			AddRow("LOCK_TIMEOUT", "43200", "43200", "", "Number of seconds to wait while trying to lock a resource.", "NUMBER")
+		mock.ExpectQuery(`^SHOW PARAMETERS LIKE '%TIMEOUT%' IN ACCOUNT$`).WillReturnRows(rows)
+
+		diags := datasources.ReadParameters(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("ACCOUNT|||%TIMEOUT%|", d.Id())
+	r.Equal(2, d.Get("parameters.#"))
+	r.Equal("STATEMENT_TIMEOUT_IN_SECONDS", d.Get("parameters.0.key"))
+	r.Equal("3600", d.Get("parameters.0.value"))
+	r.Equal("172800", d.Get("parameters.0.default"))
+	r.Equal("ACCOUNT", d.Get("parameters.0.level"))
+	r.Equal("NUMBER", d.Get("parameters.0.type"))
+	r.Equal("", d.Get("parameters.1.level"))
+}
+
+func TestParametersObject(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Parameters().Schema, map[string]interface{}{
+		"parameter_type": "OBJECT",
+		"object_type":    "DATABASE",
+		"object_name":    `"db"`,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows(parameterColumns).
+			AddRow("DATA_RETENTION_TIME_IN_DAYS", "7", "1", "DATABASE", "number of days to retain the old version of deleted/updated data", "NUMBER")
+		mock.ExpectQuery(`^SHOW PARAMETERS IN DATABASE "db"$`).WillReturnRows(rows)
+
+		diags := datasources.ReadParameters(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(1, d.Get("parameters.#"))
+	r.Equal("DATABASE", d.Get("parameters.0.level"))
+}
+
+func TestParametersObjectRequiresName(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Parameters().Schema, map[string]interface{}{
+		"parameter_type": "OBJECT",
+		"object_type":    "DATABASE",
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := datasources.ReadParameters(context.Background(), d, db)
+		r.NotEmpty(diags)
+	})
+}
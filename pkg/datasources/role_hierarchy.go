@@ -0,0 +1,149 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var roleHierarchySchema = map[string]*schema.Schema{
+	"role": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The role to start from.",
+	},
+	"max_depth": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      10,
+		Description:  "How many levels of grants to follow in each direction. 1 only returns the roles and users the role is directly granted to, the users of those roles and the roles directly granted to it.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"parent_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The roles that inherit the role, directly or through other roles.",
+	},
+	"child_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The roles the role inherits, directly or through other roles.",
+	},
+	"users": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The users granted the role, directly or through one of its parent roles.",
+	},
+}
+
+// RoleHierarchy returns a pointer to the data source walking the grants of and
+// to a role
+func RoleHierarchy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadRoleHierarchy,
+		Schema:      roleHierarchySchema,
+	}
+}
+
+// ReadRoleHierarchy implements schema.ReadContextFunc
+func ReadRoleHierarchy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	role := d.Get("role").(string)
+	maxDepth := d.Get("max_depth").(int)
+
+	users := map[string]bool{}
+	listGrantees := func(r string) ([]string, error) {
+		grantees, err := snowflake.ListRoleGrantees(ctx, db, r)
+		if err != nil {
+			return nil, err
+		}
+		roles := []string{}
+		for _, g := range grantees {
+			switch g.GrantedTo.String {
+			case "ROLE":
+				roles = append(roles, g.GranteeName.String)
+			case "USER":
+				users[g.GranteeName.String] = true
+			}
+		}
+		return roles, nil
+	}
+	parents, lastParents, err := walkRoles(role, maxDepth, listGrantees)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading parent roles of %v", role))
+	}
+	// the users of the last level of parent roles are granted the role too,
+	// but the roles those are granted to are past max_depth
+	for _, r := range lastParents {
+		if _, err := listGrantees(r); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error reading parent roles of %v", role))
+		}
+	}
+
+	children, _, err := walkRoles(role, maxDepth, func(r string) ([]string, error) {
+		return snowflake.ListGrantedRoles(ctx, db, r)
+	})
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading child roles of %v", role))
+	}
+
+	d.SetId(role)
+	if err := d.Set("parent_roles", parents); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("child_roles", children); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("users", sortedKeys(users)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// walkRoles follows next breadth first from role for at most maxDepth levels
+// and returns the sorted names of the roles it reached, along with the roles
+// of the last level, which next wasn't called on. Each role is only visited
+// once, so cycles in the grants end the walk instead of looping.
+func walkRoles(role string, maxDepth int, next func(string) ([]string, error)) ([]string, []string, error) {
+	visited := map[string]bool{role: true}
+	found := map[string]bool{}
+
+	level := []string{role}
+	for depth := 0; depth < maxDepth && len(level) > 0; depth++ {
+		nextLevel := []string{}
+		for _, r := range level {
+			roles, err := next(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, n := range roles {
+				if visited[n] {
+					continue
+				}
+				visited[n] = true
+				found[n] = true
+				nextLevel = append(nextLevel, n)
+			}
+		}
+		level = nextLevel
+	}
+	return sortedKeys(found), level, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
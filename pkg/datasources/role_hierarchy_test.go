@@ -0,0 +1,59 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleHierarchy(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.RoleHierarchy().Schema, map[string]interface{}{
+		"role":      "ANALYST",
+		"max_depth": 2,
+	})
+	r.NotNil(d)
+
+	ofColumns := []string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}
+	toColumns := []string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"}
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW GRANTS OF ROLE "ANALYST"$`).WillReturnRows(sqlmock.NewRows(ofColumns).
+			AddRow("_", "ANALYST", "ROLE", "ENGINEER", "SECURITYADMIN").
+			AddRow("_", "ANALYST", "USER", "alice", "SECURITYADMIN"))
+		// ANALYST is granted back to itself through ENGINEER
+		mock.ExpectQuery(`^SHOW GRANTS OF ROLE "ENGINEER"$`).WillReturnRows(sqlmock.NewRows(ofColumns).
+			AddRow("_", "ENGINEER", "ROLE", "ANALYST", "SECURITYADMIN").
+			AddRow("_", "ENGINEER", "ROLE", "SYSADMIN", "SECURITYADMIN").
+			AddRow("_", "ENGINEER", "USER", "bob", "SECURITYADMIN").
+			AddRow("_", "ENGINEER", "USER", "alice", "SECURITYADMIN"))
+		// SYSADMIN is at the depth limit, so only its users are collected and
+		// ACCOUNTADMIN isn't walked
+		mock.ExpectQuery(`^SHOW GRANTS OF ROLE "SYSADMIN"$`).WillReturnRows(sqlmock.NewRows(ofColumns).
+			AddRow("_", "SYSADMIN", "ROLE", "ACCOUNTADMIN", "SECURITYADMIN").
+			AddRow("_", "SYSADMIN", "USER", "carol", "SECURITYADMIN"))
+
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "ANALYST"$`).WillReturnRows(sqlmock.NewRows(toColumns).
+			AddRow(time.Time{}, "USAGE", "ROLE", "READER", "ROLE", "ANALYST", false, "SECURITYADMIN").
+			AddRow(time.Time{}, "USAGE", "WAREHOUSE", "WH", "ROLE", "ANALYST", false, "SYSADMIN"))
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "READER"$`).WillReturnRows(sqlmock.NewRows(toColumns).
+			AddRow(time.Time{}, "USAGE", "ROLE", "ANALYST", "ROLE", "READER", false, "SECURITYADMIN").
+			AddRow(time.Time{}, "SELECT", "TABLE", "DB.PUBLIC.T", "ROLE", "READER", false, "SYSADMIN"))
+
+		diags := datasources.ReadRoleHierarchy(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("ANALYST", d.Id())
+	r.Equal([]interface{}{"ENGINEER", "SYSADMIN"}, d.Get("parent_roles"))
+	r.Equal([]interface{}{"READER"}, d.Get("child_roles"))
+	r.Equal([]interface{}{"alice", "bob", "carol"}, d.Get("users"))
+}
@@ -0,0 +1,76 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var rolesSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The roles matching the filter.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Roles returns a pointer to the data source listing roles
+func Roles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadRoles,
+		Schema:      rolesSchema,
+	}
+}
+
+// ReadRoles implements schema.ReadContextFunc
+func ReadRoles(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	stmt := withShowFilters(d, snowflake.Show("ROLES")).Statement()
+
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing roles"))
+	}
+	defer rows.Close()
+
+	found, err := snowflake.ScanRoles(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing roles"))
+	}
+
+	roles := make([]map[string]interface{}, 0, len(found))
+	for _, r := range found {
+		roles = append(roles, map[string]interface{}{
+			"name":    r.Name.String,
+			"comment": r.Comment.String,
+			"owner":   r.Owner.String,
+		})
+	}
+
+	d.SetId(showID(d))
+	if err := d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
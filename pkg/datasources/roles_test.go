@@ -0,0 +1,36 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoles(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Roles().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "is_inherited", "assigned_to_users", "granted_to_roles", "granted_roles", "owner", "comment"}).
+			AddRow("2021-01-01 00:00:00", "ANALYST", "N", "N", "N", 3, 1, 0, "SECURITYADMIN", "read only").
+			AddRow("2021-01-01 00:00:00", "PUBLIC", "N", "N", "N", 0, 0, 0, "", nil)
+		mock.ExpectQuery(`^SHOW ROLES$`).WillReturnRows(rows)
+
+		diags := datasources.ReadRoles(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(2, d.Get("roles.#"))
+	r.Equal("ANALYST", d.Get("roles.0.name"))
+	r.Equal("SECURITYADMIN", d.Get("roles.0.owner"))
+	r.Equal("read only", d.Get("roles.0.comment"))
+	r.Equal("", d.Get("roles.1.comment"))
+}
@@ -0,0 +1,93 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var schemasSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to list the schemas.",
+	},
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"schemas": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The schemas matching the filters.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Schemas returns a pointer to the data source listing the schemas of a database
+func Schemas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadSchemas,
+		Schema:      schemasSchema,
+	}
+}
+
+// ReadSchemas implements schema.ReadContextFunc
+func ReadSchemas(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	stmt := withShowFilters(d, snowflake.Show("SCHEMAS").InDatabase(database)).Statement()
+
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing schemas in database %v", database))
+	}
+	defer rows.Close()
+
+	found, err := snowflake.ScanSchemas(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing schemas in database %v", database))
+	}
+
+	schemas := make([]map[string]interface{}, 0, len(found))
+	for _, s := range found {
+		schemas = append(schemas, map[string]interface{}{
+			"name":       s.Name.String,
+			"database":   s.DatabaseName.String,
+			"owner":      s.Owner.String,
+			"comment":    s.Comment.String,
+			"created_on": s.CreatedOn.String,
+		})
+	}
+
+	d.SetId(showID(d, database))
+	if err := d.Set("schemas", schemas); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
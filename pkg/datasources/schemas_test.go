@@ -0,0 +1,38 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemas(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Schemas().Schema, map[string]interface{}{
+		"database":    "db",
+		"starts_with": "RAW",
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "owner", "comment", "options", "retention_time"}).
+			AddRow("2021-01-01 00:00:00", "RAW_EVENTS", "db", "SYSADMIN", "events", "", 1)
+		mock.ExpectQuery(`^SHOW SCHEMAS IN DATABASE "db" STARTS WITH 'RAW'$`).WillReturnRows(rows)
+
+		diags := datasources.ReadSchemas(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("db||RAW", d.Id())
+	r.Equal(1, d.Get("schemas.#"))
+	r.Equal("RAW_EVENTS", d.Get("schemas.0.name"))
+	r.Equal("db", d.Get("schemas.0.database"))
+	r.Equal("events", d.Get("schemas.0.comment"))
+}
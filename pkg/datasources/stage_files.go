@@ -0,0 +1,104 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var stageFilesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database of the stage.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema of the stage.",
+	},
+	"stage": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the stage.",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "A regular expression the full path of the files must match, e.g. `.*[.]csv`.",
+	},
+	"files": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The files in the stage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"md5": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"last_modified": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// StageFiles returns a pointer to the data source listing the files in a stage
+func StageFiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadStageFiles,
+		Schema:      stageFilesSchema,
+	}
+}
+
+// ReadStageFiles implements schema.ReadContextFunc
+func ReadStageFiles(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	stage := d.Get("stage").(string)
+	pattern := d.Get("pattern").(string)
+
+	rows, err := snowflake.Query(ctx, db, snowflake.Stage(stage, database, schemaName).List(pattern))
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing files in stage %v", stage))
+	}
+	defer rows.Close()
+
+	found, err := snowflake.ScanStageFiles(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing files in stage %v", stage))
+	}
+
+	files := make([]map[string]interface{}, 0, len(found))
+	for _, f := range found {
+		files = append(files, map[string]interface{}{
+			"name":          f.Name,
+			"size":          f.Size,
+			"md5":           f.MD5.String,
+			"last_modified": f.LastModified,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%v|%v|%v|%v", database, schemaName, stage, pattern))
+	if err := d.Set("files", files); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageFiles(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.StageFiles().Schema, map[string]interface{}{
+		"database": "db",
+		"schema":   "schema",
+		"stage":    "landing",
+		"pattern":  ".*[.]csv",
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "size", "md5", "last_modified"}).
+			AddRow("s3://bucket/landing/a.csv", 1024, "0cc175b9c0f1b6a831c399e269772661", "Mon, 1 Feb 2021 00:00:00 GMT").
+			AddRow("s3://bucket/landing/b.csv", 0, nil, "Tue, 2 Feb 2021 00:00:00 GMT")
+		mock.ExpectQuery(`^` + regexp.QuoteMeta(`LIST @"db"."schema"."landing" PATTERN = '.*[.]csv'`) + `$`).WillReturnRows(rows)
+
+		diags := datasources.ReadStageFiles(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("db|schema|landing|.*[.]csv", d.Id())
+	r.Equal(2, d.Get("files.#"))
+	r.Equal("s3://bucket/landing/a.csv", d.Get("files.0.name"))
+	r.Equal(1024, d.Get("files.0.size"))
+	r.Equal("0cc175b9c0f1b6a831c399e269772661", d.Get("files.0.md5"))
+	r.Equal("Mon, 1 Feb 2021 00:00:00 GMT", d.Get("files.0.last_modified"))
+	r.Equal("", d.Get("files.1.md5"))
+}
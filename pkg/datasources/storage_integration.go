@@ -0,0 +1,66 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var storageIntegrationProperties = []integrationProperty{
+	{name: "STORAGE_PROVIDER", description: "The cloud storage provider, e.g. S3, GCS or AZURE."},
+	{name: "STORAGE_ALLOWED_LOCATIONS", description: "The locations stages using the integration may reference.", list: true},
+	{name: "STORAGE_BLOCKED_LOCATIONS", description: "The locations stages using the integration may not reference.", list: true},
+	{name: "STORAGE_AWS_IAM_USER_ARN", description: "The AWS IAM user Snowflake uses to assume the role, to trust in the role's trust policy."},
+	{name: "STORAGE_AWS_ROLE_ARN", description: "The AWS IAM role Snowflake assumes to access the storage."},
+	{name: "STORAGE_AWS_EXTERNAL_ID", description: "The external ID Snowflake passes when assuming the role, to require in the role's trust policy."},
+	{name: "STORAGE_GCP_SERVICE_ACCOUNT", description: "The Google Cloud service account Snowflake uses, to grant access to the buckets."},
+	{name: "AZURE_TENANT_ID", description: "The Azure tenant of the storage accounts."},
+	{name: "AZURE_CONSENT_URL", description: "The URL to visit to grant Snowflake access to the tenant."},
+	{name: "AZURE_MULTI_TENANT_APP_NAME", description: "The Snowflake client application created for the account, to grant access to the storage accounts."},
+	{name: "COMMENT", description: "The comment of the integration."},
+}
+
+// StorageIntegration returns a pointer to the data source describing a storage
+// integration
+func StorageIntegration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadStorageIntegration,
+		Schema:      integrationSchema(storageIntegrationProperties),
+	}
+}
+
+// ReadStorageIntegration implements schema.ReadContextFunc
+func ReadStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+	integration := snowflake.StorageIntegration(name)
+
+	row := snowflake.QueryRow(ctx, db, integration.Show())
+	s, err := snowflake.ScanStorageIntegration(row)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading storage integration %v", name))
+	}
+	if c := s.Category.String; c != "STORAGE" {
+		return diag.FromErr(fmt.Errorf("expected %v to be a STORAGE integration, got %v", name, c))
+	}
+
+	d.SetId(name)
+	if err := d.Set("type", s.IntegrationType.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := readIntegrationProperties(ctx, d, db, integration, storageIntegrationProperties); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
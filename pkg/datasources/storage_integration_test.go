@@ -0,0 +1,67 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageIntegration(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.StorageIntegration().Schema, map[string]interface{}{"name": "s3"})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		showRows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+			AddRow("S3", "EXTERNAL_STAGE", "STORAGE", true, nil, "2021-01-01 00:00:00")
+		mock.ExpectQuery(`^SHOW STORAGE INTEGRATIONS LIKE 's3'$`).WillReturnRows(showRows)
+
+		descRows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("ENABLED", "Boolean", "true", "false").
+			AddRow("STORAGE_PROVIDER", "String", "S3", nil).
+			AddRow("STORAGE_ALLOWED_LOCATIONS", "List", "s3://bucket-a/path-a/,s3://bucket-b/", "[]").
+			AddRow("STORAGE_BLOCKED_LOCATIONS", "List", "", "[]").
+			AddRow("STORAGE_AWS_IAM_USER_ARN", "String", "arn:aws:iam::000000000000:user/test", nil).
+			AddRow("STORAGE_AWS_ROLE_ARN", "String", "arn:aws:iam::000000000001:role/test", nil).
+			AddRow("STORAGE_AWS_EXTERNAL_ID", "String", "AGreatExternalID", nil).
+			AddRow("COMMENT", "String", nil, nil)
+		mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "s3"$`).WillReturnRows(descRows)
+
+		diags := datasources.ReadStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("s3", d.Id())
+	r.Equal("EXTERNAL_STAGE", d.Get("type"))
+	r.Equal(true, d.Get("enabled"))
+	r.Equal("S3", d.Get("storage_provider"))
+	r.Equal([]interface{}{"s3://bucket-a/path-a/", "s3://bucket-b/"}, d.Get("storage_allowed_locations"))
+	r.Empty(d.Get("storage_blocked_locations"))
+	r.Equal("arn:aws:iam::000000000000:user/test", d.Get("storage_aws_iam_user_arn"))
+	r.Equal("AGreatExternalID", d.Get("storage_aws_external_id"))
+	r.Equal("", d.Get("storage_gcp_service_account"))
+	r.Equal("S3", d.Get("properties.STORAGE_PROVIDER"))
+}
+
+func TestStorageIntegrationWrongCategory(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.StorageIntegration().Schema, map[string]interface{}{"name": "queue"})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		showRows := sqlmock.NewRows([]string{"name", "type", "category", "enabled", "comment", "created_on"}).
+			AddRow("QUEUE", "QUEUE - AZURE_STORAGE_QUEUE", "NOTIFICATION", true, nil, "2021-01-01 00:00:00")
+		mock.ExpectQuery(`^SHOW STORAGE INTEGRATIONS LIKE 'queue'$`).WillReturnRows(showRows)
+
+		diags := datasources.ReadStorageIntegration(context.Background(), d, db)
+		r.NotEmpty(diags)
+	})
+}
@@ -0,0 +1,95 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var systemAllowlistSchema = map[string]*schema.Schema{
+	"endpoints": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The hosts and ports your network must allow to reach Snowflake.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The kind of endpoint, e.g. SNOWFLAKE_DEPLOYMENT, STAGE or OCSP_CACHE.",
+				},
+				"host": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// SystemAllowlist returns a pointer to the data source calling SYSTEM$ALLOWLIST
+func SystemAllowlist() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return readSystemAllowlist(ctx, d, meta, snowflake.SystemAllowlist())
+		},
+		Schema: systemAllowlistSchema,
+	}
+}
+
+// SystemWhitelist returns a pointer to the data source calling
+// SYSTEM$WHITELIST, the name SYSTEM$ALLOWLIST had before it was renamed
+func SystemWhitelist() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return readSystemAllowlist(ctx, d, meta, snowflake.SystemWhitelist())
+		},
+		Schema: systemAllowlistSchema,
+	}
+}
+
+func readSystemAllowlist(ctx context.Context, d *schema.ResourceData, meta interface{}, builder *snowflake.SystemAllowlistBuilder) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	sel := builder.Select()
+	row := snowflake.QueryRow(ctx, db, sel)
+	rawAllowlist, err := snowflake.ScanAllowlist(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] system allowlist not found")
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries, err := rawAllowlist.GetStructuredConfig()
+	if err != nil {
+		log.Printf("[DEBUG] system allowlist failed to decode")
+		return diag.FromErr(err)
+	}
+
+	endpoints := make([]map[string]interface{}, 0, len(entries))
+	for _, e := range entries {
+		endpoints = append(endpoints, map[string]interface{}{
+			"type": e.Type,
+			"host": e.Host,
+			"port": e.Port,
+		})
+	}
+
+	d.SetId("allowlist")
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemAllowlist(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.SystemAllowlist().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"allowlist"}).
+			AddRow(`[{"type":"SNOWFLAKE_DEPLOYMENT","host":"ab1234.snowflakecomputing.com","port":443},{"type":"OCSP_CACHE","host":"ocsp.snowflakecomputing.com","port":80}]`)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT SYSTEM$ALLOWLIST() AS "allowlist"`)).WillReturnRows(rows)
+
+		diags := datasources.SystemAllowlist().ReadContext(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(2, d.Get("endpoints.#"))
+	r.Equal("SNOWFLAKE_DEPLOYMENT", d.Get("endpoints.0.type"))
+	r.Equal("ab1234.snowflakecomputing.com", d.Get("endpoints.0.host"))
+	r.Equal(443, d.Get("endpoints.0.port"))
+	r.Equal(80, d.Get("endpoints.1.port"))
+}
+
+func TestSystemWhitelist(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.SystemWhitelist().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"allowlist"}).AddRow(`[]`)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT SYSTEM$WHITELIST() AS "allowlist"`)).WillReturnRows(rows)
+
+		diags := datasources.SystemWhitelist().ReadContext(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(0, d.Get("endpoints.#"))
+}
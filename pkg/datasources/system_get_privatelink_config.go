@@ -0,0 +1,94 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var systemGetPrivateLinkConfigSchema = map[string]*schema.Schema{
+	"account_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of your Snowflake account.",
+	},
+
+	"aws_vpce_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The AWS VPCE ID for your account.",
+	},
+
+	"azure_pls_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Azure Private Link Service ID for your account.",
+	},
+
+	"account_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL used to connect to Snowflake through AWS PrivateLink or Azure Private Link.",
+	},
+
+	"ocsp_url": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The OCSP URL corresponding to your Snowflake account that uses AWS PrivateLink or Azure Private Link.",
+	},
+}
+
+// SystemGetPrivateLinkConfig returns a pointer to the data source calling
+// SYSTEM$GET_PRIVATELINK_CONFIG
+func SystemGetPrivateLinkConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadSystemGetPrivateLinkConfig,
+		Schema:      systemGetPrivateLinkConfigSchema,
+	}
+}
+
+// ReadSystemGetPrivateLinkConfig implements schema.ReadContextFunc
+func ReadSystemGetPrivateLinkConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	sel := snowflake.SystemGetPrivateLinkConfig().Select()
+	row := snowflake.QueryRow(ctx, db, sel)
+	rawConfig, err := snowflake.ScanPrivateLinkConfig(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] system_get_privatelink_config not found")
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, err := rawConfig.GetStructuredConfig()
+	if err != nil {
+		log.Printf("[DEBUG] system_get_privatelink_config failed to decode")
+		return diag.FromErr(err)
+	}
+
+	d.SetId(config.AccountName)
+	if err := d.Set("account_name", config.AccountName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("aws_vpce_id", config.AwsVpceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("azure_pls_id", config.AzurePrivateLinkServiceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("account_url", config.AccountURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ocsp_url", config.OCSPURL); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
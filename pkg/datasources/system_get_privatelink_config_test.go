@@ -0,0 +1,36 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemGetPrivateLinkConfig(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.SystemGetPrivateLinkConfig().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"config"}).
+			AddRow(`{"privatelink-account-name":"ab1234.us-west-2.privatelink","privatelink-vpce-id":"com.amazonaws.vpce.us-west-2.vpce-svc-0123","privatelink-account-url":"ab1234.us-west-2.privatelink.snowflakecomputing.com","privatelink_ocsp-url":"ocsp.ab1234.us-west-2.privatelink.snowflakecomputing.com"}`)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT SYSTEM$GET_PRIVATELINK_CONFIG() AS "config"`)).WillReturnRows(rows)
+
+		diags := datasources.ReadSystemGetPrivateLinkConfig(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("ab1234.us-west-2.privatelink", d.Id())
+	r.Equal("com.amazonaws.vpce.us-west-2.vpce-svc-0123", d.Get("aws_vpce_id"))
+	r.Equal("", d.Get("azure_pls_id"))
+	r.Equal("ab1234.us-west-2.privatelink.snowflakecomputing.com", d.Get("account_url"))
+	r.Equal("ocsp.ab1234.us-west-2.privatelink.snowflakecomputing.com", d.Get("ocsp_url"))
+}
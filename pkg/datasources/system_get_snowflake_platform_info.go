@@ -0,0 +1,69 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var systemGetSnowflakePlatformInfoSchema = map[string]*schema.Schema{
+	"aws_vpc_ids": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "Snowflake's AWS VPC IDs, e.g. to allow them in an S3 bucket policy. Empty if the account is not on AWS.",
+	},
+
+	"azure_vnet_subnet_ids": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "Snowflake's Azure VNet subnet IDs. Empty if the account is not on Azure.",
+	},
+}
+
+// SystemGetSnowflakePlatformInfo returns a pointer to the data source calling
+// SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO
+func SystemGetSnowflakePlatformInfo() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadSystemGetSnowflakePlatformInfo,
+		Schema:      systemGetSnowflakePlatformInfoSchema,
+	}
+}
+
+// ReadSystemGetSnowflakePlatformInfo implements schema.ReadContextFunc
+func ReadSystemGetSnowflakePlatformInfo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	sel := snowflake.SystemGetSnowflakePlatformInfo().Select()
+	row := snowflake.QueryRow(ctx, db, sel)
+	rawInfo, err := snowflake.ScanSnowflakePlatformInfo(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] system_get_snowflake_platform_info not found")
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	info, err := rawInfo.GetStructuredConfig()
+	if err != nil {
+		log.Printf("[DEBUG] system_get_snowflake_platform_info failed to decode")
+		return diag.FromErr(err)
+	}
+
+	d.SetId("snowflake_platform_info")
+	if err := d.Set("aws_vpc_ids", info.AwsVpcIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("azure_vnet_subnet_ids", info.AzureVnetSubnetIds); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
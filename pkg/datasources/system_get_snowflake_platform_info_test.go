@@ -0,0 +1,32 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemGetSnowflakePlatformInfo(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.SystemGetSnowflakePlatformInfo().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"info"}).AddRow(`{"snowflake-vpc-id":["vpc-1","vpc-2"]}`)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO() AS "info"`)).WillReturnRows(rows)
+
+		diags := datasources.ReadSystemGetSnowflakePlatformInfo(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal([]interface{}{"vpc-1", "vpc-2"}, d.Get("aws_vpc_ids"))
+	r.Empty(d.Get("azure_vnet_subnet_ids"))
+}
@@ -0,0 +1,137 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var systemPipeStatusSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database of the pipe.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema of the pipe.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the pipe.",
+	},
+
+	"execution_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The execution state of the pipe, e.g. RUNNING or PAUSED.",
+	},
+	"pending_file_count": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of files queued for loading by the pipe.",
+	},
+	"notification_channel_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Amazon SQS queue or Microsoft Azure Storage queue associated with the pipe.",
+	},
+	"num_outstanding_messages_on_channel": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of messages in the queue that have been queued but not received yet.",
+	},
+	"last_ingested_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The timestamp when the most recent file was loaded into the target table.",
+	},
+	"last_ingested_file_path": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The path of the most recent file loaded into the target table.",
+	},
+	"last_received_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The timestamp of the last event message received from the message queue.",
+	},
+	"last_forwarded_message_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The timestamp of the last event message that was forwarded to the pipe.",
+	},
+	"error": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The error message produced when the pipe was last compiled for execution, if any.",
+	},
+	"fault": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The most recent internal Snowflake process error, if any.",
+	},
+}
+
+// SystemPipeStatus returns a pointer to the data source calling
+// SYSTEM$PIPE_STATUS
+func SystemPipeStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadSystemPipeStatus,
+		Schema:      systemPipeStatusSchema,
+	}
+}
+
+// ReadSystemPipeStatus implements schema.ReadContextFunc
+func ReadSystemPipeStatus(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+
+	sel := snowflake.SystemPipeStatus(name, database, schemaName).Select()
+	row := snowflake.QueryRow(ctx, db, sel)
+	rawStatus, err := snowflake.ScanPipeStatus(row)
+	if err == sql.ErrNoRows {
+		// If not found, mark resource to be removed from statefile during apply or refresh
+		log.Printf("[DEBUG] system_pipe_status (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, err := rawStatus.GetStructuredConfig()
+	if err != nil {
+		log.Printf("[DEBUG] system_pipe_status (%s) failed to decode", d.Id())
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%v|%v|%v", database, schemaName, name))
+	values := map[string]interface{}{
+		"execution_state":                     status.ExecutionState,
+		"pending_file_count":                  status.PendingFileCount,
+		"notification_channel_name":           status.NotificationChannelName,
+		"num_outstanding_messages_on_channel": status.NumOutstandingMessagesOnChannel,
+		"last_ingested_timestamp":             status.LastIngestedTimestamp,
+		"last_ingested_file_path":             status.LastIngestedFilePath,
+		"last_received_message_timestamp":     status.LastReceivedMessageTimestamp,
+		"last_forwarded_message_timestamp":    status.LastForwardedMessageTimestamp,
+		"error":                               status.Error,
+		"fault":                               status.Fault,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemPipeStatus(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.SystemPipeStatus().Schema, map[string]interface{}{
+		"database": "db",
+		"schema":   "schema",
+		"name":     "pipe",
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"status"}).
+			AddRow(`{"executionState":"RUNNING","pendingFileCount":2,"notificationChannelName":"arn:aws:sqs:us-west-2:123:sf-snowpipe","numOutstandingMessagesOnChannel":1}`)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT SYSTEM$PIPE_STATUS('"db"."schema"."pipe"') AS "status"`)).WillReturnRows(rows)
+
+		diags := datasources.ReadSystemPipeStatus(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("db|schema|pipe", d.Id())
+	r.Equal("RUNNING", d.Get("execution_state"))
+	r.Equal(2, d.Get("pending_file_count"))
+	r.Equal("arn:aws:sqs:us-west-2:123:sf-snowpipe", d.Get("notification_channel_name"))
+	r.Equal(1, d.Get("num_outstanding_messages_on_channel"))
+	r.Equal("", d.Get("error"))
+}
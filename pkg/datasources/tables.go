@@ -0,0 +1,176 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var tablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to list the tables.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to list the tables.",
+	},
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"include_columns": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Whether to describe each table to list its columns. Disable it to list large schemas with a single query.",
+	},
+	"tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The tables matching the filters.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"column": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"nullable": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"default": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"comment": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"identity": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"start_num": {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+										"step_num": {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// Tables returns a pointer to the data source listing the tables of a schema
+func Tables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadTables,
+		Schema:      tablesSchema,
+	}
+}
+
+// ReadTables implements schema.ReadContextFunc
+func ReadTables(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	stmt := withShowFilters(d, snowflake.Show("TABLES").InSchema(database, schemaName)).Statement()
+
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing tables in schema %v.%v", database, schemaName))
+	}
+	found, err := snowflake.ScanTables(rows)
+	rows.Close()
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing tables in schema %v.%v", database, schemaName))
+	}
+
+	tables := make([]map[string]interface{}, 0, len(found))
+	for _, t := range found {
+		table := map[string]interface{}{
+			"name":       t.TableName.String,
+			"database":   t.DatabaseName.String,
+			"schema":     t.SchemaName.String,
+			"owner":      t.Owner.String,
+			"comment":    t.Comment.String,
+			"created_on": t.CreatedOn.String,
+		}
+		if d.Get("include_columns").(bool) {
+			columns, err := readTableColumns(ctx, db, t.TableName.String, database, schemaName)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			table["column"] = columns
+		}
+		tables = append(tables, table)
+	}
+
+	d.SetId(showID(d, database, schemaName))
+	if err := d.Set("tables", tables); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func readTableColumns(ctx context.Context, db *sql.DB, name, database, schemaName string) ([]interface{}, error) {
+	stmt := snowflake.Table(name, database, schemaName).ShowColumns()
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error describing table %v", name)
+	}
+	defer rows.Close()
+
+	tds, err := snowflake.ScanTableDescription(rows)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error describing table %v", name)
+	}
+	return snowflake.NewColumns(tds).Flatten(), nil
+}
@@ -0,0 +1,72 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTables(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Tables().Schema, map[string]interface{}{
+		"database": "db",
+		"schema":   "s",
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "kind", "comment", "owner"}).
+			AddRow("2021-01-01 00:00:00", "events", "db", "s", "TABLE", "all events", "SYSADMIN")
+		mock.ExpectQuery(`^SHOW TABLES IN SCHEMA "db"."s"$`).WillReturnRows(rows)
+
+		columns := sqlmock.NewRows([]string{"name", "type", "kind", "null?", "default", "comment"}).
+			AddRow("id", "NUMBER(38,0)", "COLUMN", "N", "IDENTITY START 1 INCREMENT 1", nil).
+			AddRow("payload", "VARIANT", "COLUMN", "Y", nil, "raw event")
+		mock.ExpectQuery(`^DESC TABLE "db"."s"."events"$`).WillReturnRows(columns)
+
+		diags := datasources.ReadTables(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(1, d.Get("tables.#"))
+	r.Equal("events", d.Get("tables.0.name"))
+	r.Equal("all events", d.Get("tables.0.comment"))
+	r.Equal(2, d.Get("tables.0.column.#"))
+	r.Equal("id", d.Get("tables.0.column.0.name"))
+	r.Equal(false, d.Get("tables.0.column.0.nullable"))
+	r.Equal(1, d.Get("tables.0.column.0.identity.0.step_num"))
+	r.Equal("VARIANT", d.Get("tables.0.column.1.type"))
+	r.Equal(true, d.Get("tables.0.column.1.nullable"))
+	r.Equal("raw event", d.Get("tables.0.column.1.comment"))
+}
+
+func TestTablesWithoutColumns(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Tables().Schema, map[string]interface{}{
+		"database":        "db",
+		"schema":          "s",
+		"like":            "evt%",
+		"include_columns": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "kind", "comment", "owner"}).
+			AddRow("2021-01-01 00:00:00", "evt_a", "db", "s", "TABLE", "", "SYSADMIN")
+		mock.ExpectQuery(`^SHOW TABLES LIKE 'evt%' IN SCHEMA "db"."s"$`).WillReturnRows(rows)
+
+		diags := datasources.ReadTables(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(1, d.Get("tables.#"))
+	r.Equal(0, d.Get("tables.0.column.#"))
+}
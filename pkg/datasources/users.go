@@ -0,0 +1,97 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var usersSchema = map[string]*schema.Schema{
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"users": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The users matching the filters.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"login_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"disabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"default_role": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"default_warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"email": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Users returns a pointer to the data source listing users
+func Users() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadUsers,
+		Schema:      usersSchema,
+	}
+}
+
+// ReadUsers implements schema.ReadContextFunc
+func ReadUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	stmt := withShowFilters(d, snowflake.Show("USERS")).Statement()
+
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing users"))
+	}
+	defer rows.Close()
+
+	found, err := snowflake.ScanUsers(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing users"))
+	}
+
+	users := make([]map[string]interface{}, 0, len(found))
+	for _, u := range found {
+		users = append(users, map[string]interface{}{
+			"name":              u.Name.String,
+			"login_name":        u.LoginName.String,
+			"comment":           u.Comment.String,
+			"disabled":          u.Disabled,
+			"default_role":      u.DefaultRole.String,
+			"default_warehouse": u.DefaultWarehouse.String,
+			"email":             u.Email.String,
+		})
+	}
+
+	d.SetId(showID(d))
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsers(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Users().Schema, map[string]interface{}{"starts_with": "SVC_"})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "login_name", "comment", "disabled", "default_role", "default_warehouse", "email"}).
+			AddRow("SVC_DBT", "svc_dbt", "dbt runner", "false", "TRANSFORMER", "TRANSFORMING", nil).
+			AddRow("SVC_OLD", "svc_old", "", "true", nil, nil, nil)
+		mock.ExpectQuery(`^SHOW USERS STARTS WITH 'SVC_'$`).WillReturnRows(rows)
+
+		diags := datasources.ReadUsers(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(2, d.Get("users.#"))
+	r.Equal("svc_dbt", d.Get("users.0.login_name"))
+	r.Equal("TRANSFORMER", d.Get("users.0.default_role"))
+	r.False(d.Get("users.0.disabled").(bool))
+	r.True(d.Get("users.1.disabled").(bool))
+	r.Equal("", d.Get("users.1.default_role"))
+}
@@ -0,0 +1,109 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var viewsSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to list the views.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to list the views.",
+	},
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"views": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The views matching the filters.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created_on": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_secure": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Views returns a pointer to the data source listing the views of a schema
+func Views() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadViews,
+		Schema:      viewsSchema,
+	}
+}
+
+// ReadViews implements schema.ReadContextFunc
+func ReadViews(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	stmt := withShowFilters(d, snowflake.Show("VIEWS").InSchema(database, schemaName)).Statement()
+
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing views in schema %v.%v", database, schemaName))
+	}
+	defer rows.Close()
+
+	found, err := snowflake.ScanViews(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error listing views in schema %v.%v", database, schemaName))
+	}
+
+	views := make([]map[string]interface{}, 0, len(found))
+	for _, v := range found {
+		views = append(views, map[string]interface{}{
+			"name":       v.Name.String,
+			"database":   v.DatabaseName.String,
+			"schema":     v.SchemaName.String,
+			"owner":      v.Owner.String,
+			"comment":    v.Comment.String,
+			"created_on": v.CreatedOn.String,
+			"is_secure":  v.IsSecure,
+		})
+	}
+
+	d.SetId(showID(d, database, schemaName))
+	if err := d.Set("views", views); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViews(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Views().Schema, map[string]interface{}{
+		"database": "db",
+		"schema":   "s",
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "text", "is_secure"}).
+			AddRow("2021-01-01 00:00:00", "v", "db", "s", "SYSADMIN", "a view", "select 1", true)
+		mock.ExpectQuery(`^SHOW VIEWS IN SCHEMA "db"."s"$`).WillReturnRows(rows)
+
+		diags := datasources.ReadViews(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal("db|s||", d.Id())
+	r.Equal(1, d.Get("views.#"))
+	r.Equal("v", d.Get("views.0.name"))
+	r.Equal("SYSADMIN", d.Get("views.0.owner"))
+	r.True(d.Get("views.0.is_secure").(bool))
+}
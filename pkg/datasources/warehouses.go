@@ -0,0 +1,91 @@
+package datasources
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+var warehousesSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"warehouses": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The warehouses matching the filter.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"size": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"auto_suspend": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+// Warehouses returns a pointer to the data source listing warehouses
+func Warehouses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ReadWarehouses,
+		Schema:      warehousesSchema,
+	}
+}
+
+// ReadWarehouses implements schema.ReadContextFunc
+func ReadWarehouses(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	stmt := withShowFilters(d, snowflake.Show("WAREHOUSES")).Statement()
+
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing warehouses"))
+	}
+	defer rows.Close()
+
+	found, err := snowflake.ScanWarehouses(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error listing warehouses"))
+	}
+
+	warehouses := make([]map[string]interface{}, 0, len(found))
+	for _, w := range found {
+		warehouses = append(warehouses, map[string]interface{}{
+			"name":         w.Name,
+			"comment":      w.Comment,
+			"size":         w.Size,
+			"state":        w.State,
+			"auto_suspend": w.AutoSuspend,
+			"owner":        w.Owner,
+		})
+	}
+
+	d.SetId(showID(d))
+	if err := d.Set("warehouses", warehouses); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package datasources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouses(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, datasources.Warehouses().Schema, map[string]interface{}{"like": "%etl%"})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"name", "state", "type", "size", "auto_suspend", "auto_resume", "owner", "comment"}).
+			AddRow("ETL_WH", "SUSPENDED", "STANDARD", "Large", 600, "true", "SYSADMIN", "batch jobs").
+			AddRow("ETL_WH_DEV", "STARTED", "STANDARD", "X-Small", 60, "true", "SYSADMIN", "")
+		mock.ExpectQuery(`^SHOW WAREHOUSES LIKE '%etl%'$`).WillReturnRows(rows)
+
+		diags := datasources.ReadWarehouses(context.Background(), d, db)
+		r.Empty(diags)
+	})
+
+	r.Equal(2, d.Get("warehouses.#"))
+	r.Equal("ETL_WH", d.Get("warehouses.0.name"))
+	r.Equal("Large", d.Get("warehouses.0.size"))
+	r.Equal("SUSPENDED", d.Get("warehouses.0.state"))
+	r.Equal(600, d.Get("warehouses.0.auto_suspend"))
+	r.Equal("batch jobs", d.Get("warehouses.0.comment"))
+	r.Equal(60, d.Get("warehouses.1.auto_suspend"))
+}
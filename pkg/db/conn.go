@@ -0,0 +1,312 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"log"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/pkg/errors"
+)
+
+// limiter is a counting semaphore shared by all connections of a pool. A nil
+// limiter never blocks.
+type limiter chan struct{}
+
+func newLimiter(n int) limiter {
+	if n <= 0 {
+		return nil
+	}
+	return make(limiter, n)
+}
+
+// acquire blocks until a slot is free or ctx is done. The returned func
+// releases the slot.
+func (l limiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// connector opens connections through parent and wraps them so statements go
+// through the pool's limiter and run as the role carried by their context.
+type connector struct {
+	parent  driver.Connector
+	limiter limiter
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	parent, err := c.parent.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: parent, limiter: c.limiter}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.parent.Driver()
+}
+
+// conn delegates to the wrapped driver.Conn. Optional interfaces the parent
+// does not implement return driver.ErrSkip so database/sql falls back to its
+// default behavior.
+//
+// Before a statement runs, conn switches the session to the role set with
+// snowflake.WithRole. Statements without a role run as the role the session
+// started with, so a role never leaks to other resources sharing the pool.
+type conn struct {
+	driver.Conn
+	limiter limiter
+
+	// role is the role conn switched the session to, or "" if the session
+	// still uses its default role.
+	role string
+	// defaultRole is the session's role before the first switch. It is only
+	// looked up once a statement asks for a role.
+	defaultRole      string
+	defaultRoleKnown bool
+}
+
+var (
+	_ driver.ConnBeginTx        = &conn{}
+	_ driver.ConnPrepareContext = &conn{}
+	_ driver.ExecerContext      = &conn{}
+	_ driver.QueryerContext     = &conn{}
+	_ driver.Pinger             = &conn{}
+	_ driver.NamedValueChecker  = &conn{}
+	_ driver.SessionResetter    = &conn{}
+)
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := c.useRole(ctx); err != nil {
+		return nil, err
+	}
+	return execer.ExecContext(ctx, query, args)
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := c.useRole(ctx); err != nil {
+		return nil, err
+	}
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := c.useRole(ctx); err != nil {
+		return nil, err
+	}
+
+	var parent driver.Stmt
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		parent, err = preparer.PrepareContext(ctx, query)
+	} else {
+		parent, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{Stmt: parent, conn: c}, nil
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.useRole(ctx); err != nil {
+		return nil, err
+	}
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin() // nolint: staticcheck
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *conn) CheckNamedValue(v *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(v)
+	}
+	return driver.ErrSkip
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+// stmt delegates to the wrapped driver.Stmt, running it through the limiter
+// and as the role of its context like the statements of conn.
+type stmt struct {
+	driver.Stmt
+	conn *conn
+}
+
+var (
+	_ driver.StmtExecContext  = &stmt{}
+	_ driver.StmtQueryContext = &stmt{}
+)
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	release, err := s.conn.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := s.conn.useRole(ctx); err != nil {
+		return nil, err
+	}
+	if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+		return execer.ExecContext(ctx, args)
+	}
+	values, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	return s.Stmt.Exec(values) // nolint: staticcheck
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	release, err := s.conn.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := s.conn.useRole(ctx); err != nil {
+		return nil, err
+	}
+	if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		return queryer.QueryContext(ctx, args)
+	}
+	values, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	return s.Stmt.Query(values) // nolint: staticcheck
+}
+
+// namedValuesToValues converts arguments for drivers predating
+// driver.NamedValue, which only support positional arguments.
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, errors.New("driver does not support named arguments")
+		}
+		values[i] = arg.Value
+	}
+	return values, nil
+}
+
+// useRole switches the session to the role requested by ctx, restoring the
+// default role if ctx does not request one.
+func (c *conn) useRole(ctx context.Context) error {
+	want := snowflake.RoleFromContext(ctx)
+	if want == c.role {
+		return nil
+	}
+
+	if want == "" {
+		if c.defaultRole == "" {
+			// the session had no role to go back to; have database/sql retry
+			// on a fresh connection and discard this one
+			log.Printf("[DEBUG] cannot restore default role after using %s, discarding connection", c.role)
+			return driver.ErrBadConn
+		}
+		if err := c.exec(ctx, snowflake.UseRole(c.defaultRole)); err != nil {
+			return errors.Wrapf(err, "error restoring role %s", c.defaultRole)
+		}
+		c.role = ""
+		return nil
+	}
+
+	if !c.defaultRoleKnown {
+		role, err := c.currentRole(ctx)
+		if err != nil {
+			return errors.Wrap(err, "error reading current role")
+		}
+		c.defaultRole = role
+		c.defaultRoleKnown = true
+	}
+	if err := c.exec(ctx, snowflake.UseRole(want)); err != nil {
+		return errors.Wrapf(err, "error using role %s", want)
+	}
+	c.role = want
+	return nil
+}
+
+func (c *conn) exec(ctx context.Context, query string) error {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return errors.New("driver does not support role switching")
+	}
+	_, err := execer.ExecContext(ctx, query, nil)
+	return err
+}
+
+// currentRole returns the session's current role, or "" if it has none.
+func (c *conn) currentRole(ctx context.Context) (string, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return "", errors.New("driver does not support role switching")
+	}
+	rows, err := queryer.QueryContext(ctx, "SELECT CURRENT_ROLE()", nil)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	dest := make([]driver.Value, len(rows.Columns()))
+	if len(dest) == 0 {
+		return "", nil
+	}
+	if err := rows.Next(dest); err != nil {
+		if err == io.EOF {
+			return "", nil
+		}
+		return "", err
+	}
+	role, _ := dest[0].(string)
+	return role, nil
+}
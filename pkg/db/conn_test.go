@@ -0,0 +1,271 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConnector hands out fakeConns whose statements block until release is
+// closed.
+type fakeConnector struct {
+	started chan string
+	release chan struct{}
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver { return nil }
+
+type fakeConn struct {
+	c *fakeConnector
+}
+
+func (f fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{f, query}, nil }
+func (fakeConn) Close() error                                { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                   { return nil, driver.ErrSkip }
+
+func (f fakeConn) ExecContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	f.c.started <- query
+	select {
+	case <-f.c.release:
+		return driver.RowsAffected(0), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (f fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if _, err := f.ExecContext(ctx, query, args); err != nil {
+		return nil, err
+	}
+	return emptyRows{}, nil
+}
+
+// fakeStmt only implements the methods of driver.Stmt, which run like the
+// statements of its fakeConn.
+type fakeStmt struct {
+	f     fakeConn
+	query string
+}
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+
+func (s fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return s.f.ExecContext(context.Background(), s.query, nil)
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return s.f.QueryContext(context.Background(), s.query, nil)
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+func TestOpenConnectorPoolSettings(t *testing.T) {
+	r := require.New(t)
+
+	db := openConnector(&fakeConnector{}, Config{MaxOpenConns: 4, ConnMaxLifetime: time.Minute})
+	defer db.Close()
+
+	r.Equal(4, db.Stats().MaxOpenConnections)
+}
+
+func TestMaxConcurrentStatements(t *testing.T) {
+	r := require.New(t)
+
+	fc := &fakeConnector{started: make(chan string, 2), release: make(chan struct{})}
+	db := openConnector(fc, Config{MaxConcurrentStatements: 1})
+	defer db.Close()
+
+	errs := make(chan error)
+	go func() {
+		_, err := db.ExecContext(context.Background(), "first")
+		errs <- err
+	}()
+	r.Equal("first", <-fc.started)
+
+	// the only slot is held by the first statement, so the second one waits
+	// until its context expires without reaching the driver
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := db.QueryContext(ctx, "second")
+	r.Equal(context.DeadlineExceeded, err)
+	r.Len(fc.started, 0)
+
+	close(fc.release)
+	r.NoError(<-errs)
+
+	// the slot is free again
+	rows, err := db.QueryContext(context.Background(), "third")
+	r.NoError(err)
+	r.NoError(rows.Close())
+	r.Equal("third", <-fc.started)
+}
+
+func TestMaxConcurrentPreparedStatements(t *testing.T) {
+	r := require.New(t)
+
+	fc := &fakeConnector{started: make(chan string, 2), release: make(chan struct{})}
+	db := openConnector(fc, Config{MaxConcurrentStatements: 1})
+	defer db.Close()
+
+	// the statement is prepared on its own connection, so it doesn't have to be
+	// prepared again while the other one is busy
+	c, err := db.Conn(context.Background())
+	r.NoError(err)
+	defer c.Close()
+	prepared, err := c.PrepareContext(context.Background(), "prepared")
+	r.NoError(err)
+	defer prepared.Close()
+
+	errs := make(chan error)
+	go func() {
+		_, err := db.ExecContext(context.Background(), "first")
+		errs <- err
+	}()
+	r.Equal("first", <-fc.started)
+
+	// neither preparing nor running a prepared statement gets past the limit
+	for _, run := range []func(context.Context) error{
+		func(ctx context.Context) error {
+			_, err := db.PrepareContext(ctx, "second")
+			return err
+		},
+		func(ctx context.Context) error {
+			_, err := prepared.ExecContext(ctx)
+			return err
+		},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		r.Equal(context.DeadlineExceeded, run(ctx))
+		cancel()
+	}
+	r.Len(fc.started, 0)
+
+	close(fc.release)
+	r.NoError(<-errs)
+
+	_, err = prepared.ExecContext(context.Background())
+	r.NoError(err)
+	r.Equal("prepared", <-fc.started)
+}
+
+func TestNoStatementLimit(t *testing.T) {
+	r := require.New(t)
+
+	fc := &fakeConnector{started: make(chan string, 3), release: make(chan struct{})}
+	db := openConnector(fc, Config{})
+	defer db.Close()
+
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, err := db.ExecContext(context.Background(), "stmt")
+			errs <- err
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		r.Equal("stmt", <-fc.started)
+	}
+
+	close(fc.release)
+	for i := 0; i < 3; i++ {
+		r.NoError(<-errs)
+	}
+}
+
+// recordingConn records every statement it is asked to run and reports
+// defaultRole as the session's current role.
+type recordingConn struct {
+	fakeConn
+	defaultRole string
+	queries     []string
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) { return nil, nil }
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.queries = append(c.queries, query)
+	return driver.RowsAffected(0), nil
+}
+
+func (c *recordingConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.queries = append(c.queries, query)
+	if query == "SELECT CURRENT_ROLE()" {
+		return &roleRows{role: c.defaultRole}, nil
+	}
+	return emptyRows{}, nil
+}
+
+type roleRows struct {
+	role string
+	done bool
+}
+
+func (r *roleRows) Columns() []string { return []string{"CURRENT_ROLE()"} }
+func (r *roleRows) Close() error      { return nil }
+func (r *roleRows) Next(dest []driver.Value) error {
+	if r.done || r.role == "" {
+		return io.EOF
+	}
+	dest[0] = r.role
+	r.done = true
+	return nil
+}
+
+func TestConnUseRole(t *testing.T) {
+	r := require.New(t)
+
+	parent := &recordingConn{defaultRole: "SYSADMIN"}
+	c := &conn{Conn: parent}
+	ctx := context.Background()
+	securityAdmin := snowflake.WithRole(ctx, "SECURITYADMIN")
+
+	_, err := c.ExecContext(ctx, "SHOW DATABASES", nil)
+	r.NoError(err)
+	_, err = c.ExecContext(securityAdmin, "CREATE ROLE r", nil)
+	r.NoError(err)
+	_, err = c.QueryContext(securityAdmin, "SHOW ROLES", nil)
+	r.NoError(err)
+	_, err = c.ExecContext(ctx, "SHOW DATABASES", nil)
+	r.NoError(err)
+	_, err = c.BeginTx(securityAdmin, driver.TxOptions{})
+	r.NoError(err)
+
+	r.Equal([]string{
+		"SHOW DATABASES",
+		"SELECT CURRENT_ROLE()",
+		`USE ROLE "SECURITYADMIN"`,
+		"CREATE ROLE r",
+		"SHOW ROLES",
+		`USE ROLE "SYSADMIN"`,
+		"SHOW DATABASES",
+		`USE ROLE "SECURITYADMIN"`,
+	}, parent.queries)
+}
+
+func TestConnUseRoleWithoutDefaultRole(t *testing.T) {
+	r := require.New(t)
+
+	parent := &recordingConn{}
+	c := &conn{Conn: parent}
+
+	_, err := c.ExecContext(snowflake.WithRole(context.Background(), "SECURITYADMIN"), "CREATE ROLE r", nil)
+	r.NoError(err)
+
+	// there is no role to restore, so the connection must not be reused
+	_, err = c.ExecContext(context.Background(), "SHOW DATABASES", nil)
+	r.Equal(driver.ErrBadConn, err)
+}
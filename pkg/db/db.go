@@ -3,25 +3,67 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/luna-duclos/instrumentedsql"
 	"github.com/snowflakedb/gosnowflake"
 )
 
+var instrumentedDriver instrumentedsql.WrappedDriver
+
 func init() {
 	re := regexp.MustCompile(`\r?\n`)
 
 	logger := instrumentedsql.LoggerFunc(func(ctx context.Context, msg string, keyvals ...interface{}) {
 		s := fmt.Sprintf("[DEBUG] %s %v\n", msg, keyvals)
-		log.Println(re.ReplaceAllString(s, " "))
+		log.Println(re.ReplaceAllString(snowflake.RedactSecrets(s), " "))
 	})
 
-	sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger)))
+	instrumentedDriver = instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger))
+	sql.Register("snowflake-instrumented", instrumentedDriver)
+}
+
+// Config holds the connection pool settings applied by Open. The zero value
+// keeps the database/sql defaults and does not limit statement concurrency.
+type Config struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+
+	// MaxConcurrentStatements bounds the number of statements executing at once
+	// across all connections. Statements over the limit wait for a free slot
+	// until their context is done.
+	MaxConcurrentStatements int
 }
 
-func Open(dsn string) (*sql.DB, error) {
-	return sql.Open("snowflake-instrumented", dsn)
+// Open returns a connection pool for dsn configured according to cfg.
+func Open(dsn string, cfg Config) (*sql.DB, error) {
+	c, err := instrumentedDriver.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return openConnector(c, cfg), nil
+}
+
+func openConnector(c driver.Connector, cfg Config) *sql.DB {
+	db := sql.OpenDB(&connector{
+		parent:  c,
+		limiter: newLimiter(cfg.MaxConcurrentStatements),
+	})
+
+	if cfg.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+	return db
 }
@@ -2,12 +2,15 @@ package provider
 
 import (
 	"crypto/rsa"
+	"fmt"
 	"io/ioutil"
+	"time"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/datasources"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/db"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
 	"github.com/snowflakedb/gosnowflake"
@@ -16,6 +19,9 @@ import (
 
 // Provider is a provider
 func Provider() *schema.Provider {
+	// set when the provider is configured, before any resource is used
+	var deletionProtection bool
+
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"account": {
@@ -66,12 +72,47 @@ func Provider() *schema.Provider {
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_REGION", "us-west-2"),
 			},
+			"max_open_conns": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_OPEN_CONNS", 0),
+				Description:  "Maximum number of open connections (sessions) to Snowflake. 0 means unlimited.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_idle_conns": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_IDLE_CONNS", 0),
+				Description:  "Maximum number of idle connections kept in the pool. 0 keeps the database/sql default.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"conn_max_lifetime": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_CONN_MAX_LIFETIME", ""),
+				Description:  "Maximum amount of time a connection may be reused, as a duration such as `30m`. Connections are reused forever if unset.",
+				ValidateFunc: validateDuration,
+			},
+			"max_concurrent_statements": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_CONCURRENT_STATEMENTS", 0),
+				Description:  "Maximum number of statements executed concurrently. Statements over the limit wait for a free slot. 0 means unlimited.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_DELETION_PROTECTION", false),
+				Description: "Default of the deletion_protection attribute of the resources supporting it, i.e. databases, schemas, tables and stages.",
+			},
 		},
-		ResourcesMap: getResources(),
-		DataSourcesMap: map[string]*schema.Resource{
-			"snowflake_system_get_aws_sns_iam_policy": datasources.SystemGetAWSSNSIAMPolicy(),
+		ResourcesMap:   getResources(func() bool { return deletionProtection }),
+		DataSourcesMap: getDataSources(),
+		ConfigureFunc: func(s *schema.ResourceData) (interface{}, error) {
+			deletionProtection = s.Get("deletion_protection").(bool)
+			return ConfigureProvider(s)
 		},
-		ConfigureFunc: ConfigureProvider,
 	}
 }
 
@@ -97,14 +138,20 @@ func GetGrantResources() resources.TerraformGrantResources {
 	return grants
 }
 
-func getResources() map[string]*schema.Resource {
+// getResources returns the resources of the provider. deletionProtection
+// returns the provider's default for their deletion_protection attribute.
+func getResources(deletionProtection func() bool) map[string]*schema.Resource {
 	others := map[string]*schema.Resource{
+		"snowflake_account_parameter":         resources.AccountParameter(),
 		"snowflake_database":                  resources.Database(),
+		"snowflake_failover_group":            resources.FailoverGroup(),
 		"snowflake_managed_account":           resources.ManagedAccount(),
 		"snowflake_masking_policy":            resources.MaskingPolicy(),
 		"snowflake_network_policy_attachment": resources.NetworkPolicyAttachment(),
 		"snowflake_network_policy":            resources.NetworkPolicy(),
+		"snowflake_object_parameter":          resources.ObjectParameter(),
 		"snowflake_pipe":                      resources.Pipe(),
+		"snowflake_replication_group":         resources.ReplicationGroup(),
 		"snowflake_resource_monitor":          resources.ResourceMonitor(),
 		"snowflake_role_grants":               resources.RoleGrants(),
 		"snowflake_role":                      resources.Role(),
@@ -123,10 +170,41 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_warehouse":                 resources.Warehouse(),
 	}
 
-	return mergeSchemas(
+	all := mergeSchemas(
 		others,
 		GetGrantResources().GetTfSchemas(),
 	)
+	for name, r := range all {
+		all[name] = resources.WithExecutionRole(resources.WithDeletionProtectionDefault(r, deletionProtection))
+	}
+	return all
+}
+
+func getDataSources() map[string]*schema.Resource {
+	dataSources := map[string]*schema.Resource{
+		"snowflake_current_account":                    datasources.CurrentAccount(),
+		"snowflake_databases":                          datasources.Databases(),
+		"snowflake_grants":                             datasources.Grants(),
+		"snowflake_notification_integration":           datasources.NotificationIntegration(),
+		"snowflake_parameters":                         datasources.Parameters(),
+		"snowflake_role_hierarchy":                     datasources.RoleHierarchy(),
+		"snowflake_roles":                              datasources.Roles(),
+		"snowflake_schemas":                            datasources.Schemas(),
+		"snowflake_stage_files":                        datasources.StageFiles(),
+		"snowflake_storage_integration":                datasources.StorageIntegration(),
+		"snowflake_system_allowlist":                   datasources.SystemAllowlist(),
+		"snowflake_system_get_aws_sns_iam_policy":      datasources.SystemGetAWSSNSIAMPolicy(),
+		"snowflake_system_get_privatelink_config":      datasources.SystemGetPrivateLinkConfig(),
+		"snowflake_system_get_snowflake_platform_info": datasources.SystemGetSnowflakePlatformInfo(),
+		"snowflake_system_pipe_status":                 datasources.SystemPipeStatus(),
+		"snowflake_system_whitelist":                   datasources.SystemWhitelist(),
+		"snowflake_tables":                             datasources.Tables(),
+		"snowflake_users":                              datasources.Users(),
+		"snowflake_views":                              datasources.Views(),
+		"snowflake_warehouses":                         datasources.Warehouses(),
+	}
+
+	return dataSources
 }
 
 func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
@@ -145,7 +223,12 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 		return nil, errors.Wrap(err, "could not build dsn for snowflake connection")
 	}
 
-	db, err := db.Open(dsn)
+	cfg, err := dbConfig(s)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := db.Open(dsn, cfg)
 	if err != nil {
 		return nil, errors.Wrap(err, "Could not open snowflake database.")
 	}
@@ -153,6 +236,40 @@ func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
 	return db, nil
 }
 
+func dbConfig(s *schema.ResourceData) (db.Config, error) {
+	cfg := db.Config{
+		MaxOpenConns:            s.Get("max_open_conns").(int),
+		MaxIdleConns:            s.Get("max_idle_conns").(int),
+		MaxConcurrentStatements: s.Get("max_concurrent_statements").(int),
+	}
+
+	if v := s.Get("conn_max_lifetime").(string); v != "" {
+		lifetime, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, errors.Wrap(err, "could not parse conn_max_lifetime")
+		}
+		cfg.ConnMaxLifetime = lifetime
+	}
+	return cfg, nil
+}
+
+func validateDuration(i interface{}, k string) (s []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if v == "" {
+		return
+	}
+	if d, err := time.ParseDuration(v); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a duration such as 30m: %v", k, err))
+	} else if d < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", k))
+	}
+	return
+}
+
 func DSN(
 	account,
 	user,
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,9 +53,9 @@ var accountGrantSchema = map[string]*schema.Schema{
 func AccountGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateAccountGrant,
-			Read:   ReadAccountGrant,
-			Delete: DeleteAccountGrant,
+			CreateContext: CreateAccountGrant,
+			ReadContext:   ReadAccountGrant,
+			DeleteContext: DeleteAccountGrant,
 
 			Schema: accountGrantSchema,
 		},
@@ -60,16 +63,16 @@ func AccountGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateAccountGrant implements schema.CreateFunc
-func CreateAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateAccountGrant implements schema.CreateContextFunc
+func CreateAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 
 	builder := snowflake.AccountGrant()
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := &grantID{
@@ -79,36 +82,42 @@ func CreateAccountGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grantID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadAccountGrant(d, meta)
+	return ReadAccountGrant(ctx, d, meta)
 }
 
-// ReadAccountGrant implements schema.ReadFunc
-func ReadAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadAccountGrant implements schema.ReadContextFunc
+func ReadAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", grantID.Privilege)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.AccountGrant()
 
-	return readGenericGrant(d, meta, accountGrantSchema, builder, false, validAccountPrivileges)
+	if err := readGenericGrant(ctx, d, meta, accountGrantSchema, builder, false, validAccountPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteAccountGrant implements schema.DeleteFunc
-func DeleteAccountGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteAccountGrant implements schema.DeleteContextFunc
+func DeleteAccountGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	builder := snowflake.AccountGrant()
 
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var accountParameterSchema = map[string]*schema.Schema{
+	"key": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Name of the account parameter, e.g. TIMEZONE.",
+		ValidateFunc:     validation.StringInSlice(parameterKeys(snowflake.AccountParameters), true),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"value": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Value of the account parameter. Booleans and numbers are given as strings, e.g. \"true\" or \"3600\".",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			def, ok := snowflake.AccountParameters[strings.ToUpper(d.Get("key").(string))]
+			return ok && def.Equal(old, new)
+		},
+	},
+}
+
+// AccountParameter returns a pointer to the resource representing an account
+// parameter
+func AccountParameter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAccountParameter,
+		ReadContext:   ReadAccountParameter,
+		UpdateContext: UpdateAccountParameter,
+		DeleteContext: DeleteAccountParameter,
+		CustomizeDiff: customizeAccountParameterDiff,
+
+		Schema: accountParameterSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func parameterKeys(parameters map[string]snowflake.ParameterDefinition) []string {
+	keys := make([]string, 0, len(parameters))
+	for k := range parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// customizeAccountParameterDiff validates the value against the type of the
+// parameter so that invalid values fail at plan time
+func customizeAccountParameterDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	key := strings.ToUpper(d.Get("key").(string))
+	def, ok := snowflake.AccountParameters[key]
+	if !ok {
+		return nil
+	}
+	if err := def.Validate(d.Get("value").(string)); err != nil {
+		return errors.Wrapf(err, "invalid value for account parameter %v", key)
+	}
+	return nil
+}
+
+// CreateAccountParameter implements schema.CreateContextFunc
+func CreateAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	key := strings.ToUpper(d.Get("key").(string))
+	if err := setAccountParameter(ctx, d, meta.(*sql.DB), key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(key)
+	return ReadAccountParameter(ctx, d, meta)
+}
+
+// ReadAccountParameter implements schema.ReadContextFunc
+func ReadAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	key := d.Id()
+
+	rows, err := snowflake.Query(ctx, db, snowflake.AccountParameter(key).Show())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading account parameter %v", key))
+	}
+	defer rows.Close()
+
+	params, err := snowflake.ScanParameters(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading account parameter %v", key))
+	}
+
+	for _, p := range params {
+		// LIKE treats _ as a wildcard, so other keys can match too
+		if p.Key != key {
+			continue
+		}
+		if err := d.Set("key", p.Key); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("value", p.Value); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] account parameter (%s) not found", key)
+	d.SetId("")
+	return nil
+}
+
+// UpdateAccountParameter implements schema.UpdateContextFunc
+func UpdateAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("value") {
+		if err := setAccountParameter(ctx, d, meta.(*sql.DB), d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadAccountParameter(ctx, d, meta)
+}
+
+// DeleteAccountParameter implements schema.DeleteContextFunc. It restores the
+// parameter's default value.
+func DeleteAccountParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	key := d.Id()
+
+	if err := snowflake.Exec(ctx, db, snowflake.AccountParameter(key).Unset()); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error unsetting account parameter %v", key))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setAccountParameter(ctx context.Context, d *schema.ResourceData, db *sql.DB, key string) error {
+	def, ok := snowflake.AccountParameters[key]
+	if !ok {
+		return fmt.Errorf("unknown account parameter %v", key)
+	}
+	value := d.Get("value").(string)
+	if err := def.Validate(value); err != nil {
+		return errors.Wrapf(err, "invalid value for account parameter %v", key)
+	}
+
+	err := snowflake.Exec(ctx, db, snowflake.AccountParameter(key).Set(def.Literal(value)))
+	return errors.Wrapf(err, "error setting account parameter %v", key)
+}
@@ -0,0 +1,117 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountParameter(t *testing.T) {
+	r := require.New(t)
+	err := resources.AccountParameter().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAccountParameterCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":   "timezone",
+		"value": "America/Los_Angeles",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AccountParameter().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SET TIMEZONE = 'America/Los_Angeles'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccountParameter(mock, "TIMEZONE", "America/Los_Angeles", "ACCOUNT")
+
+		diags := resources.CreateAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+	r.Equal("TIMEZONE", d.Id())
+	r.Equal("TIMEZONE", d.Get("key"))
+	r.Equal("America/Los_Angeles", d.Get("value"))
+}
+
+func TestAccountParameterCreateBooleanSpelling(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":   "PERIODIC_DATA_REKEYING",
+		"value": "t",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AccountParameter().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SET PERIODIC_DATA_REKEYING = true$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadAccountParameter(mock, "PERIODIC_DATA_REKEYING", "true", "ACCOUNT")
+
+		diags := resources.CreateAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestAccountParameterCreateInvalidValue(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"key":   "PERIODIC_DATA_REKEYING",
+		"value": "yes",
+	}
+	d := schema.TestResourceDataRaw(t, resources.AccountParameter().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.CreateAccountParameter(context.Background(), d, db)
+		r.NotEmpty(diags)
+	})
+}
+
+func TestAccountParameterRead(t *testing.T) {
+	r := require.New(t)
+
+	d := accountParameter(t, "PERIODIC_DATA_REKEYING", map[string]interface{}{
+		"key":   "PERIODIC_DATA_REKEYING",
+		"value": "TRUE",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadAccountParameter(mock, "PERIODIC_DATA_REKEYING", "true", "ACCOUNT")
+
+		diags := resources.ReadAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+	r.Equal("true", d.Get("value"))
+}
+
+func TestAccountParameterDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := accountParameter(t, "TIMEZONE", map[string]interface{}{
+		"key":   "TIMEZONE",
+		"value": "UTC",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT UNSET TIMEZONE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := resources.DeleteAccountParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+	r.Equal("", d.Id())
+}
+
+func expectReadAccountParameter(mock sqlmock.Sqlmock, key, value, level string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow(key, value, "", level, "", "")
+	mock.ExpectQuery(`^SHOW PARAMETERS LIKE '` + key + `' IN ACCOUNT$`).WillReturnRows(rows)
+}
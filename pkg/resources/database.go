@@ -1,13 +1,19 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 )
 
@@ -27,20 +33,68 @@ var databaseSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 	},
+	"is_transient": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		Description:   "Specifies a database as transient. Transient databases do not have a Fail-safe period so they do not incur additional storage costs once they leave Time Travel; however, this means they are also not protected by Fail-safe in the event of a data loss. Databases created from a share or a replica cannot be transient.",
+		ForceNew:      true,
+		ConflictsWith: []string{"from_share", "from_replica"},
+	},
 	"from_share": {
 		Type:          schema.TypeMap,
 		Description:   "Specify a provider and a share in this map to create a database from a share.",
 		Optional:      true,
 		ForceNew:      true,
-		ConflictsWith: []string{"from_database"},
+		ConflictsWith: []string{"from_database", "from_replica"},
 	},
 	"from_database": {
 		Type:          schema.TypeString,
 		Description:   "Specify a database to create a clone from.",
 		Optional:      true,
 		ForceNew:      true,
-		ConflictsWith: []string{"from_share"},
+		ConflictsWith: []string{"from_share", "from_replica"},
+	},
+	"from_replica": {
+		Type:          schema.TypeString,
+		Description:   "Specify a fully-qualified path to a database to create a replica from, i.e. organization.account.database. The database is created as a secondary database of it, which can be refreshed from the primary.",
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"from_share", "from_database"},
+		ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[^.]+\.[^.]+\.[^.]+$`), "from_replica must be organization.account.database"),
 	},
+	"replication_configuration": {
+		Type:          schema.TypeList,
+		Description:   "Allows replicating the database to other accounts of the organization.",
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"from_share", "from_replica"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"accounts": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					MinItems:    1,
+					Description: "Accounts the database can be replicated to, as organization.account in upper case, e.g. MYORG.ACCOUNT2.",
+				},
+				"ignore_edition_check": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Allows replicating the database to accounts on lower editions.",
+				},
+				"enable_failover": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Allows the accounts to promote their replica of the database to primary.",
+				},
+			},
+		},
+	},
+	"deletion_protection": deletionProtectionSchema,
+	"recover_if_dropped":  recoverIfDroppedSchema,
 }
 
 var databaseProperties = []string{"comment", "data_retention_time_in_days"}
@@ -48,77 +102,199 @@ var databaseProperties = []string{"comment", "data_retention_time_in_days"}
 // Database returns a pointer to the resource representing a database
 func Database() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateDatabase,
-		Read:   ReadDatabase,
-		Delete: DeleteDatabase,
-		Update: UpdateDatabase,
+		CreateContext: CreateDatabase,
+		ReadContext:   ReadDatabase,
+		DeleteContext: DeleteDatabase,
+		UpdateContext: UpdateDatabase,
 
 		Schema: databaseSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		// Cloning a large database with from_database can take a long time.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
-// CreateDatabase implements schema.CreateFunc
-func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
+// CreateDatabase implements schema.CreateContextFunc
+func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+	if undrop(ctx, d, db, snowflake.Database(name).Undrop()) {
+		return recoverDatabase(ctx, d, meta)
+	}
+
 	if _, ok := d.GetOk("from_share"); ok {
-		return createDatabaseFromShare(d, meta)
+		return createDatabaseFromShare(ctx, d, meta)
 	}
 
 	if _, ok := d.GetOk("from_database"); ok {
-		return createDatabaseFromDatabase(d, meta)
+		return createDatabaseFromDatabase(ctx, d, meta)
+	}
+
+	if _, ok := d.GetOk("from_replica"); ok {
+		return createDatabaseFromReplica(ctx, d, meta)
+	}
+
+	qb := snowflake.Database(name).Create()
+	if d.Get("is_transient").(bool) {
+		qb.Transient()
+	}
+	for _, field := range databaseProperties {
+		if val, ok := d.GetOk(field); ok {
+			switch val := val.(type) {
+			case string:
+				qb.SetString(field, val)
+			case int:
+				qb.SetInt(field, val)
+			}
+		}
 	}
 
-	return CreateResource("database", databaseProperties, databaseSchema, snowflake.Database, ReadDatabase)(d, meta)
+	err := snowflake.Exec(ctx, db, qb.Statement())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating database %v", name))
+	}
+
+	d.SetId(name)
+
+	err = updateDatabaseReplication(ctx, d, d, db)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabase(ctx, d, meta)
 }
 
-func createDatabaseFromShare(d *schema.ResourceData, meta interface{}) error {
+func createDatabaseFromShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	in := d.Get("from_share").(map[string]interface{})
 	prov := in["provider"]
 	share := in["share"]
 
 	if prov == nil || share == nil {
-		return fmt.Errorf("from_share must contain the keys provider and share, but it had %+v", in)
+		return diag.FromErr(fmt.Errorf("from_share must contain the keys provider and share, but it had %+v", in))
 	}
 
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	builder := snowflake.DatabaseFromShare(name, prov.(string), share.(string))
 
-	err := snowflake.Exec(db, builder.Create())
+	err := snowflake.Exec(ctx, db, builder.Create())
 	if err != nil {
-		return errors.Wrapf(err, "error creating database %v from share %v.%v", name, prov, share)
+		return diag.FromErr(errors.Wrapf(err, "error creating database %v from share %v.%v", name, prov, share))
 	}
 
 	d.SetId(name)
 
-	return ReadDatabase(d, meta)
+	return ReadDatabase(ctx, d, meta)
 }
 
-func createDatabaseFromDatabase(d *schema.ResourceData, meta interface{}) error {
+func createDatabaseFromDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceDb := d.Get("from_database").(string)
 
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	builder := snowflake.DatabaseFromDatabase(name, sourceDb)
+	if d.Get("is_transient").(bool) {
+		builder.Transient()
+	}
+
+	err := snowflake.Exec(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating a clone database %v from database %v", name, sourceDb))
+	}
+
+	d.SetId(name)
+
+	err = updateDatabaseReplication(ctx, d, d, db)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabase(ctx, d, meta)
+}
+
+func createDatabaseFromReplica(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	primary := d.Get("from_replica").(string)
+
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+	q, err := snowflake.DatabaseFromReplica(name, primary).Create()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err := snowflake.Exec(db, builder.Create())
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating a clone database %v from database %v", name, sourceDb)
+		return diag.FromErr(errors.Wrapf(err, "error creating database %v as a replica of %v", name, primary))
 	}
 
 	d.SetId(name)
 
-	return ReadDatabase(d, meta)
+	return ReadDatabase(ctx, d, meta)
+}
+
+// recoverDatabase sets the ID of the database just restored by UNDROP and
+// alters its properties to match the configuration, unless it is transient
+// and the configuration says otherwise, or the reverse.
+func recoverDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	name := d.Get("name").(string)
+
+	actual := Database().Data(nil)
+	actual.SetId(name)
+	if diags := ReadDatabase(ctx, actual, meta); diags.HasError() {
+		return diags
+	}
+	if actual.Id() == "" {
+		return diag.Errorf("database %v not found after restoring it", name)
+	}
+	if err := checkRecovered(actual, d, "is_transient"); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+
+	changes := recoveredChanges{schema: databaseSchema, actual: actual, d: d}
+	qb := snowflake.Database(name).Alter()
+	changed := false
+	for _, field := range databaseProperties {
+		if !changes.HasChange(field) {
+			continue
+		}
+		changed = true
+		switch val := d.Get(field).(type) {
+		case string:
+			qb.SetString(field, val)
+		case int:
+			qb.SetInt(field, val)
+		}
+	}
+	if changed {
+		err := snowflake.Exec(ctx, db, qb.Statement())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating database %v", name))
+		}
+	}
+
+	err := updateDatabaseReplication(ctx, d, changes, db)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabase(ctx, d, meta)
 }
 
-func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
+func ReadDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 
 	stmt := snowflake.Database(name).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	database, err := snowflake.ScanDatabase(row)
 
@@ -129,31 +305,157 @@ func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, "unable to scan row for SHOW DATABASES")
+		return diag.FromErr(errors.Wrap(err, "unable to scan row for SHOW DATABASES"))
 	}
 
 	err = d.Set("name", database.DBName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("comment", database.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	i, err := strconv.ParseInt(database.RetentionTime.String, 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("data_retention_time_in_days", i)
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("is_transient", database.IsTransient())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// secondary databases are replicated by their primary
+	if _, ok := d.GetOk("from_replica"); ok {
+		return nil
+	}
+
+	rows, err := snowflake.Query(ctx, db, snowflake.DatabaseReplication(name).Show())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "unable to query SHOW REPLICATION DATABASES"))
+	}
+	defer rows.Close()
+
+	replicationDatabases, err := snowflake.ScanReplicationDatabases(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "unable to scan rows for SHOW REPLICATION DATABASES"))
+	}
+
+	replication := []interface{}{}
+	for _, r := range replicationDatabases {
+		if !r.IsPrimary.Bool || r.Name.String != database.DBName.String {
+			continue
+		}
+		if accounts := r.ReplicationAccounts(); len(accounts) > 0 {
+			replication = append(replication, map[string]interface{}{
+				"accounts": accounts,
+				// there's no way to read it back
+				"ignore_edition_check": d.Get("replication_configuration.0.ignore_edition_check").(bool),
+				"enable_failover":      len(r.FailoverAccounts()) > 0,
+			})
+		}
+		break
+	}
+
+	err = d.Set("replication_configuration", replication)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+
+	// the ID still holds the name before any rename
+	err := updateDatabaseReplication(ctx, d, d, db)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return UpdateResource("database", databaseProperties, databaseSchema, snowflake.Database, ReadDatabase)(ctx, d, meta)
+}
+
+// updateDatabaseReplication enables or disables the replication and the
+// failover of the database of d to accounts for the given attribute changes
+func updateDatabaseReplication(ctx context.Context, d *schema.ResourceData, changes attributeChanges, db *sql.DB) error {
+	if !changes.HasChange("replication_configuration") {
+		return nil
+	}
+
+	o, n := changes.GetChange("replication_configuration")
+	builder := snowflake.DatabaseReplication(d.Id())
+	for _, q := range changeDatabaseReplication(builder, o.([]interface{}), n.([]interface{})) {
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return errors.Wrapf(err, "error updating replication of database %v", d.Id())
+		}
+	}
+	return nil
+}
+
+// changeDatabaseReplication returns the statements turning the replication
+// configuration o into n. Failover is only allowed to accounts the database is
+// replicated to, so it is disabled first and enabled last.
+func changeDatabaseReplication(builder *snowflake.DatabaseReplicationBuilder, o, n []interface{}) []string {
+	oldAccounts, oldFailover, _ := expandDatabaseReplication(o)
+	newAccounts, newFailover, ignoreEditionCheck := expandDatabaseReplication(n)
+
+	removed := sortedStrings(oldAccounts.Difference(newAccounts))
+	added := sortedStrings(newAccounts.Difference(oldAccounts))
+
+	stmts := []string{}
+	if oldFailover {
+		failoverRemoved := removed
+		if !newFailover {
+			failoverRemoved = sortedStrings(oldAccounts)
+		}
+		if len(failoverRemoved) > 0 {
+			stmts = append(stmts, builder.DisableFailover(failoverRemoved))
+		}
+	}
+	if len(removed) > 0 {
+		stmts = append(stmts, builder.DisableReplication(removed))
+	}
+	if len(added) > 0 {
+		stmts = append(stmts, builder.EnableReplication(added, ignoreEditionCheck))
+	}
+	if newFailover {
+		failoverAdded := added
+		if !oldFailover {
+			failoverAdded = sortedStrings(newAccounts)
+		}
+		if len(failoverAdded) > 0 {
+			stmts = append(stmts, builder.EnableFailover(failoverAdded))
+		}
+	}
+	return stmts
+}
+
+// expandDatabaseReplication returns the accounts of a replication_configuration
+// block, whether failover to them is enabled and whether the edition check is
+// ignored
+func expandDatabaseReplication(v []interface{}) (*schema.Set, bool, bool) {
+	if len(v) == 0 || v[0] == nil {
+		return schema.NewSet(schema.HashString, nil), false, false
+	}
+	c := v[0].(map[string]interface{})
+	return c["accounts"].(*schema.Set), c["enable_failover"].(bool), c["ignore_edition_check"].(bool)
 }
 
-func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
-	return UpdateResource("database", databaseProperties, databaseSchema, snowflake.Database, ReadDatabase)(d, meta)
+func sortedStrings(s *schema.Set) []string {
+	strs := expandStringList(s.List())
+	sort.Strings(strs)
+	return strs
 }
 
-func DeleteDatabase(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("database", snowflake.Database)(d, meta)
+func DeleteDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("database", snowflake.Database)(ctx, d, meta)
 }
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -59,9 +62,9 @@ var databaseGrantSchema = map[string]*schema.Schema{
 func DatabaseGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateDatabaseGrant,
-			Read:   ReadDatabaseGrant,
-			Delete: DeleteDatabaseGrant,
+			CreateContext: CreateDatabaseGrant,
+			ReadContext:   ReadDatabaseGrant,
+			DeleteContext: DeleteDatabaseGrant,
 
 			Schema: databaseGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -72,16 +75,16 @@ func DatabaseGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateDatabaseGrant implements schema.CreateFunc
-func CreateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateDatabaseGrant implements schema.CreateContextFunc
+func CreateDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dbName := d.Get("database_name").(string)
 	builder := snowflake.DatabaseGrant(dbName)
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return errors.Wrap(err, "error creating database grant")
+		return diag.FromErr(errors.Wrap(err, "error creating database grant"))
 	}
 
 	grant := &grantID{
@@ -91,30 +94,30 @@ func CreateDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return errors.Wrap(err, "error creating database grant")
+		return diag.FromErr(errors.Wrap(err, "error creating database grant"))
 	}
 	d.SetId(dataIDInput)
 
-	return ReadDatabaseGrant(d, meta)
+	return ReadDatabaseGrant(ctx, d, meta)
 }
 
-// ReadDatabaseGrant implements schema.ReadFunc
-func ReadDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadDatabaseGrant implements schema.ReadContextFunc
+func ReadDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("database_name", grantID.ResourceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", grantID.Privilege)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// IMPORTED PRIVILEGES is not a real resource, so we can't actually verify
@@ -124,13 +127,19 @@ func ReadDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	builder := snowflake.DatabaseGrant(grantID.ResourceName)
-	return readGenericGrant(d, meta, databaseGrantSchema, builder, false, validDatabasePrivileges)
+	if err := readGenericGrant(ctx, d, meta, databaseGrantSchema, builder, false, validDatabasePrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteDatabaseGrant implements schema.DeleteFunc
-func DeleteDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteDatabaseGrant implements schema.DeleteContextFunc
+func DeleteDatabaseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dbName := d.Get("database_name").(string)
 	builder := snowflake.DatabaseGrant(dbName)
 
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -40,8 +41,8 @@ func TestDatabaseGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON DATABASE "test-database" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON DATABASE "test-database" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadDatabaseGrant(mock)
-		err := resources.CreateDatabaseGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateDatabaseGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -60,8 +61,8 @@ func TestDatabaseGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadDatabaseGrant(mock)
-		err := resources.ReadDatabaseGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadDatabaseGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 	roles := d.Get("roles").(*schema.Set)
 	r.True(roles.Contains("test-role-1"))
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeDatabaseReplication(t *testing.T) {
+	r := require.New(t)
+	builder := snowflake.DatabaseReplication("db")
+
+	replication := func(failover bool, accounts ...interface{}) []interface{} {
+		d := Database().TestResourceData()
+		err := d.Set("replication_configuration", []interface{}{map[string]interface{}{
+			"accounts":             accounts,
+			"ignore_edition_check": false,
+			"enable_failover":      failover,
+		}})
+		r.NoError(err)
+		return d.Get("replication_configuration").([]interface{})
+	}
+
+	// nothing changes
+	r.Empty(changeDatabaseReplication(builder, replication(true, "O.A", "O.B"), replication(true, "O.B", "O.A")))
+
+	// enabled from scratch
+	r.Equal([]string{
+		`ALTER DATABASE "db" ENABLE REPLICATION TO ACCOUNTS O.A, O.B`,
+		`ALTER DATABASE "db" ENABLE FAILOVER TO ACCOUNTS O.A, O.B`,
+	}, changeDatabaseReplication(builder, nil, replication(true, "O.B", "O.A")))
+
+	// accounts swapped, failover kept on
+	r.Equal([]string{
+		`ALTER DATABASE "db" DISABLE FAILOVER TO ACCOUNTS O.A`,
+		`ALTER DATABASE "db" DISABLE REPLICATION TO ACCOUNTS O.A`,
+		`ALTER DATABASE "db" ENABLE REPLICATION TO ACCOUNTS O.C`,
+		`ALTER DATABASE "db" ENABLE FAILOVER TO ACCOUNTS O.C`,
+	}, changeDatabaseReplication(builder, replication(true, "O.A", "O.B"), replication(true, "O.B", "O.C")))
+
+	// failover turned off
+	r.Equal([]string{
+		`ALTER DATABASE "db" DISABLE FAILOVER TO ACCOUNTS O.A, O.B`,
+	}, changeDatabaseReplication(builder, replication(true, "O.A", "O.B"), replication(false, "O.A", "O.B")))
+
+	// failover turned on
+	r.Equal([]string{
+		`ALTER DATABASE "db" ENABLE FAILOVER TO ACCOUNTS O.A, O.B`,
+	}, changeDatabaseReplication(builder, replication(false, "O.A", "O.B"), replication(true, "O.A", "O.B")))
+
+	// replication removed
+	r.Equal([]string{
+		`ALTER DATABASE "db" DISABLE FAILOVER TO ACCOUNTS O.A`,
+		`ALTER DATABASE "db" DISABLE REPLICATION TO ACCOUNTS O.A`,
+	}, changeDatabaseReplication(builder, replication(true, "O.A"), nil))
+}
@@ -1,7 +1,9 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -9,6 +11,7 @@ import (
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
 	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,14 +34,115 @@ func TestDatabaseCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE DATABASE "good_name" COMMENT='great comment`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectRead(mock)
-		err := resources.CreateDatabase(d, db)
-		r.NoError(err)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestDatabaseCreateRecover(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "good_name",
+		"comment":            "great comment",
+		"recover_if_dropped": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^UNDROP DATABASE "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectRead(mock)
+		mock.ExpectExec(`^ALTER DATABASE "good_name" SET COMMENT='great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectRead(mock)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("good_name", d.Id())
+	})
+}
+
+func TestDatabaseCreateRecoverNothingDropped(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":               "good_name",
+		"comment":            "great comment",
+		"recover_if_dropped": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^UNDROP DATABASE "good_name"$`).WillReturnError(errors.New("Database 'GOOD_NAME' did not exist or was purged."))
+		mock.ExpectExec(`CREATE DATABASE "good_name" COMMENT='great comment`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectRead(mock)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
 func expectRead(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time"}).AddRow("created_on", "good_name", "is_default", "is_current", "origin", "owner", "mock comment", "options", "1")
 	mock.ExpectQuery("SHOW DATABASES LIKE 'good_name'").WillReturnRows(rows)
+	mock.ExpectQuery("SHOW REPLICATION DATABASES LIKE 'good_name'").WillReturnRows(sqlmock.NewRows(replicationDatabaseColumns))
+}
+
+var replicationDatabaseColumns = []string{"region_group", "snowflake_region", "created_on", "account_name", "name", "comment", "is_primary", "primary", "replication_allowed_to_accounts", "failover_allowed_to_accounts", "organization_name", "account_locator"}
+
+func TestDatabaseCreateReplicated(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "good_name",
+		"comment":      "great comment",
+		"is_transient": true,
+		"replication_configuration": []interface{}{map[string]interface{}{
+			"accounts":             []interface{}{"MYORG.ACCOUNT3", "MYORG.ACCOUNT2"},
+			"ignore_edition_check": true,
+			"enable_failover":      true,
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE TRANSIENT DATABASE "good_name" COMMENT='great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "good_name" ENABLE REPLICATION TO ACCOUNTS MYORG.ACCOUNT2, MYORG.ACCOUNT3 IGNORE EDITION CHECK$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER DATABASE "good_name" ENABLE FAILOVER TO ACCOUNTS MYORG.ACCOUNT2, MYORG.ACCOUNT3$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time"}).AddRow("created_on", "good_name", "N", "N", "", "SYSADMIN", "great comment", "TRANSIENT", "1")
+		mock.ExpectQuery(`^SHOW DATABASES LIKE 'good_name'$`).WillReturnRows(rows)
+		replicationRows := sqlmock.NewRows(replicationDatabaseColumns).
+			AddRow("PUBLIC", "AWS_US_WEST_2", "", "ACCOUNT1", "good_name", "", "true", "MYORG.ACCOUNT1.good_name", "MYORG.ACCOUNT1, MYORG.ACCOUNT2, MYORG.ACCOUNT3", "MYORG.ACCOUNT1, MYORG.ACCOUNT2, MYORG.ACCOUNT3", "MYORG", "AB12345").
+			AddRow("PUBLIC", "AWS_US_EAST_1", "", "ACCOUNT2", "good_name", "", "false", "MYORG.ACCOUNT1.good_name", "MYORG.ACCOUNT1, MYORG.ACCOUNT2, MYORG.ACCOUNT3", "MYORG.ACCOUNT1, MYORG.ACCOUNT2, MYORG.ACCOUNT3", "MYORG", "CD12345")
+		mock.ExpectQuery(`^SHOW REPLICATION DATABASES LIKE 'good_name'$`).WillReturnRows(replicationRows)
+
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+		r.True(d.Get("is_transient").(bool))
+		r.Equal(2, d.Get("replication_configuration.0.accounts.#"))
+		r.True(d.Get("replication_configuration.0.ignore_edition_check").(bool))
+		r.True(d.Get("replication_configuration.0.enable_failover").(bool))
+	})
+}
+
+func TestDatabaseCreateFromReplica(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "good_name",
+		"from_replica": "MYORG.ACCOUNT1.good_name",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE DATABASE "good_name" AS REPLICA OF MYORG.ACCOUNT1."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time"}).AddRow("created_on", "good_name", "N", "N", "", "SYSADMIN", "", "", "1")
+		mock.ExpectQuery(`^SHOW DATABASES LIKE 'good_name'$`).WillReturnRows(rows)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+	})
 }
 
 func TestDatabaseRead(t *testing.T) {
@@ -48,8 +152,8 @@ func TestDatabaseRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectRead(mock)
-		err := resources.ReadDatabase(d, db)
-		r.NoError(err)
+		diags := resources.ReadDatabase(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal(1, d.Get("data_retention_time_in_days").(int))
@@ -63,8 +167,8 @@ func TestDatabaseDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP DATABASE "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteDatabase(d, db)
-		r.NoError(err)
+		diags := resources.DeleteDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -84,8 +188,8 @@ func TestDatabaseCreateFromShare(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE DATABASE "good_name" FROM SHARE "abc123"."my_share"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectRead(mock)
-		err := resources.CreateDatabase(d, db)
-		r.NoError(err)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -102,7 +206,49 @@ func TestDatabaseCreateFromDatabase(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE DATABASE "good_name" CLONE "abc123"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectRead(mock)
-		err := resources.CreateDatabase(d, db)
-		r.NoError(err)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
+
+func TestDatabaseCreateTransientFromDatabase(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "good_name",
+		"from_database": "abc123",
+		"is_transient":  true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE TRANSIENT DATABASE "good_name" CLONE "abc123"`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectRead(mock)
+		diags := resources.CreateDatabase(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestDatabaseTransientConflictsWithReplica(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "good_name",
+		"from_replica": "MYORG.ACCOUNT1.good_name",
+		"is_transient": true,
+	}
+	diags := resources.Database().Validate(terraform.NewResourceConfigRaw(in))
+	r.True(diags.HasError())
+}
+
+func TestDatabaseFromReplicaMustBeQualified(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "good_name",
+		"from_replica": "good_name",
+	}
+	diags := resources.Database().Validate(terraform.NewResourceConfigRaw(in))
+	r.True(diags.HasError())
+}
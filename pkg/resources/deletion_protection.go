@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const deletionProtectionKey = "deletion_protection"
+
+var deletionProtectionSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Makes destroying or replacing the object fail while true. Set it to false and apply before destroying the object. Defaults to the provider's deletion_protection, which objects that don't set it follow from the next apply when it changes.",
+}
+
+// checkDeletionProtection returns an error if the deletion_protection
+// attribute of d is on. Resources without the attribute are never protected.
+func checkDeletionProtection(d *schema.ResourceData, kind string) error {
+	if v, ok := d.GetOk(deletionProtectionKey); ok && v.(bool) {
+		return errors.Errorf("%v %v has deletion_protection on; turn it off and apply before destroying or replacing it", kind, d.Id())
+	}
+	return nil
+}
+
+// WithDeletionProtectionDefault returns a copy of r whose deletion_protection
+// attribute defaults to enabled(), e.g. from the provider configuration. The
+// default is planned like any other, so objects whose configuration doesn't
+// set the attribute follow it when it changes rather than keeping the value
+// they were created with. Resources without the attribute are returned as is.
+func WithDeletionProtectionDefault(resource *schema.Resource, enabled func() bool) *schema.Resource {
+	s, ok := resource.Schema[deletionProtectionKey]
+	if !ok {
+		return resource
+	}
+	r := *resource
+	r.Schema = make(map[string]*schema.Schema, len(resource.Schema))
+	for k, v := range resource.Schema {
+		r.Schema[k] = v
+	}
+	withDefault := *s
+	withDefault.Default = nil
+	withDefault.DefaultFunc = func() (interface{}, error) {
+		return enabled(), nil
+	}
+	r.Schema[deletionProtectionKey] = &withDefault
+	// imported objects have no value for the attribute yet
+	r.ReadContext = withDeletionProtectionDefault(r.ReadContext, enabled)
+	return &r
+}
+
+func withDeletionProtectionDefault(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, enabled func() bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// GetOk can't tell an unset attribute from one set to false
+		if _, ok := d.GetOkExists(deletionProtectionKey); !ok { // nolint: staticcheck
+			if err := d.Set(deletionProtectionKey, enabled()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return f(ctx, d, meta)
+	}
+}
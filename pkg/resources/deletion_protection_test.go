@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDeletionProtectionDefault(t *testing.T) {
+	r := require.New(t)
+
+	noop := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return nil
+	}
+	res := resources.WithDeletionProtectionDefault(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":                {Type: schema.TypeString, Required: true, ForceNew: true},
+			"deletion_protection": {Type: schema.TypeBool, Optional: true, Default: false},
+		},
+		CreateContext: noop,
+		ReadContext:   noop,
+		DeleteContext: noop,
+	}, func() bool { return true })
+
+	unset := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "n"})
+	r.Equal(true, unset.Get("deletion_protection"))
+
+	off := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "n", "deletion_protection": false})
+	r.Equal(false, off.Get("deletion_protection"))
+
+	// objects that don't set it follow the provider when it changes
+	state := &terraform.InstanceState{ID: "n", Attributes: map[string]string{"name": "n", "deletion_protection": "false"}}
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "n"}), nil)
+	r.NoError(err)
+	r.Equal("true", diff.Attributes["deletion_protection"].New)
+
+	diff, err = res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "n", "deletion_protection": false}), nil)
+	r.NoError(err)
+	r.Nil(diff)
+
+	// imported objects get the default when read
+	imported := res.Data(&terraform.InstanceState{ID: "n", Attributes: map[string]string{"name": "n"}})
+	r.Empty(res.ReadContext(context.Background(), imported, nil))
+	r.Equal(true, imported.Get("deletion_protection"))
+
+	// resources without the attribute are left alone
+	other := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	r.Same(other, resources.WithDeletionProtectionDefault(other, func() bool { return true }))
+}
+
+func TestDeletionProtection(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		d := database(t, "drop_it", map[string]interface{}{"name": "drop_it", "deletion_protection": true})
+		r.NotEmpty(resources.DeleteDatabase(context.Background(), d, db))
+
+		d = schema.TestResourceDataRaw(t, resources.Schema().Schema, map[string]interface{}{"name": "drop_it", "database": "test_db", "deletion_protection": true})
+		d.SetId("test_db|drop_it")
+		r.NotEmpty(resources.DeleteSchema(context.Background(), d, db))
+
+		d = table(t, "database_name|schema_name|drop_it", map[string]interface{}{"name": "drop_it", "deletion_protection": true})
+		diags := resources.DeleteTable(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Equal("table database_name|schema_name|drop_it has deletion_protection on; turn it off and apply before destroying or replacing it", diags[0].Summary)
+
+		d = stage(t, "test_db|test_schema|drop_it", map[string]interface{}{"name": "drop_it", "deletion_protection": true})
+		r.NotEmpty(resources.DeleteStage(context.Background(), d, db))
+
+		// nothing was dropped
+		r.NoError(mock.ExpectationsWereMet())
+
+		mock.ExpectExec(`^DROP TABLE "database_name"."schema_name"."drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		d = table(t, "database_name|schema_name|drop_it", map[string]interface{}{"name": "drop_it", "deletion_protection": false})
+		r.Empty(resources.DeleteTable(context.Background(), d, db))
+	})
+}
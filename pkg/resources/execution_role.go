@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const executionRoleKey = "role"
+
+var executionRoleSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "Role used to run this resource's statements instead of the provider's role. The connection's role is restored afterwards.",
+}
+
+// WithExecutionRole returns a copy of r with the optional role attribute and
+// CRUD functions that run their statements as that role. r and its schema map,
+// which is usually shared with other resources, are left untouched.
+func WithExecutionRole(resource *schema.Resource) *schema.Resource {
+	r := *resource
+	r.Schema = make(map[string]*schema.Schema, len(resource.Schema)+1)
+	for k, v := range resource.Schema {
+		r.Schema[k] = v
+	}
+	r.Schema[executionRoleKey] = executionRoleSchema
+
+	r.CreateContext = withExecutionRole(r.CreateContext)
+	r.ReadContext = withExecutionRole(r.ReadContext)
+	r.DeleteContext = withExecutionRole(r.DeleteContext)
+	if r.UpdateContext == nil {
+		// every other attribute forces a new resource, so changing the role
+		// only needs to refresh the state
+		r.UpdateContext = schema.UpdateContextFunc(r.ReadContext)
+	} else {
+		r.UpdateContext = withExecutionRole(r.UpdateContext)
+	}
+	return &r
+}
+
+func withExecutionRole(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if role, ok := d.GetOk(executionRoleKey); ok {
+			ctx = snowflake.WithRole(ctx, role.(string))
+		}
+		return f(ctx, d, meta)
+	}
+}
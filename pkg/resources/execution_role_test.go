@@ -0,0 +1,41 @@
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithExecutionRole(t *testing.T) {
+	r := require.New(t)
+
+	var got []string
+	record := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		got = append(got, snowflake.RoleFromContext(ctx))
+		return nil
+	}
+	res := resources.WithExecutionRole(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+		CreateContext: record,
+		ReadContext:   record,
+		DeleteContext: record,
+	})
+	r.NoError(res.InternalValidate(nil, true))
+	r.Contains(res.Schema, "role")
+	r.NotNil(res.UpdateContext)
+
+	withRole := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "n", "role": "SECURITYADMIN"})
+	withoutRole := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "n"})
+
+	r.Empty(res.CreateContext(context.Background(), withRole, nil))
+	r.Empty(res.UpdateContext(context.Background(), withRole, nil))
+	r.Empty(res.DeleteContext(context.Background(), withoutRole, nil))
+	r.Equal([]string{"SECURITYADMIN", "SECURITYADMIN", ""}, got)
+}
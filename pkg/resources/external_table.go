@@ -2,12 +2,14 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -127,9 +129,9 @@ var externalTableSchema = map[string]*schema.Schema{
 
 func ExternalTable() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateExternalTable,
-		Read:   ReadExternalTable,
-		Delete: DeleteExternalTable,
+		CreateContext: CreateExternalTable,
+		ReadContext:   ReadExternalTable,
+		DeleteContext: DeleteExternalTable,
 
 		Schema: externalTableSchema,
 		Importer: &schema.ResourceImporter{
@@ -184,8 +186,8 @@ func externalTableIDFromString(stringID string) (*externalTableID, error) {
 	return externalTableResult, nil
 }
 
-// CreateExternalTable implements schema.CreateFunc
-func CreateExternalTable(data *schema.ResourceData, meta interface{}) error {
+// CreateExternalTable implements schema.CreateContextFunc
+func CreateExternalTable(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := data.Get("database").(string)
 	dbSchema := data.Get("schema").(string)
@@ -229,9 +231,9 @@ func CreateExternalTable(data *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating externalTable %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating externalTable %v", name))
 	}
 
 	externalTableID := &externalTableID{
@@ -241,19 +243,19 @@ func CreateExternalTable(data *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := externalTableID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	data.SetId(dataIDInput)
 
-	return ReadExternalTable(data, meta)
+	return ReadExternalTable(ctx, data, meta)
 }
 
-// ReadExternalTable implements schema.ReadFunc
-func ReadExternalTable(data *schema.ResourceData, meta interface{}) error {
+// ReadExternalTable implements schema.ReadContextFunc
+func ReadExternalTable(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	externalTableID, err := externalTableIDFromString(data.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := externalTableID.DatabaseName
@@ -261,31 +263,31 @@ func ReadExternalTable(data *schema.ResourceData, meta interface{}) error {
 	name := externalTableID.ExternalTableName
 
 	stmt := snowflake.ExternalTable(name, dbName, schema).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 	externalTable, err := snowflake.ScanExternalTable(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("name", externalTable.ExternalTableName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = data.Set("owner", externalTable.Owner.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// DeleteExternalTable implements schema.DeleteFunc
-func DeleteExternalTable(data *schema.ResourceData, meta interface{}) error {
+// DeleteExternalTable implements schema.DeleteContextFunc
+func DeleteExternalTable(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	externalTableID, err := externalTableIDFromString(data.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := externalTableID.DatabaseName
@@ -294,38 +296,12 @@ func DeleteExternalTable(data *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.ExternalTable(externalTableName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting pipe %v", data.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting pipe %v", data.Id()))
 	}
 
 	data.SetId("")
 
 	return nil
 }
-
-// ExternalTableExists implements schema.ExistsFunc
-func ExternalTableExists(data *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	externalTableID, err := externalTableIDFromString(data.Id())
-	if err != nil {
-		return false, err
-	}
-
-	dbName := externalTableID.DatabaseName
-	schema := externalTableID.SchemaName
-	externalTableName := externalTableID.ExternalTableName
-
-	q := snowflake.ExternalTable(externalTableName, dbName, schema).Show()
-	rows, err := db.Query(q)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-
-	return false, nil
-}
@@ -0,0 +1,362 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+)
+
+var failoverGroupSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the identifier for the group. A secondary group must have the name of its primary, and is replaced when renamed since only primary groups can be renamed.",
+	},
+	"object_types": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"ACCOUNT PARAMETERS", "DATABASES", "INTEGRATIONS", "NETWORK POLICIES", "RESOURCE MONITORS", "ROLES", "SHARES", "USERS", "WAREHOUSES"}, false),
+		},
+		Optional:      true,
+		Description:   "Types of the objects replicated by the group, e.g. DATABASES, SHARES, ROLES, WAREHOUSES or INTEGRATIONS. Required unless from_replica is set.",
+		ConflictsWith: []string{"from_replica"},
+	},
+	"allowed_databases": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		Description:   "Databases replicated by the group, when object_types includes DATABASES.",
+		ConflictsWith: []string{"from_replica"},
+	},
+	"allowed_shares": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		Description:   "Shares replicated by the group, when object_types includes SHARES.",
+		ConflictsWith: []string{"from_replica"},
+	},
+	"allowed_integration_types": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"API INTEGRATIONS", "NOTIFICATION INTEGRATIONS", "SECURITY INTEGRATIONS", "STORAGE INTEGRATIONS"}, false),
+		},
+		Optional:      true,
+		Description:   "Types of the integrations replicated by the group, e.g. SECURITY INTEGRATIONS, when object_types includes INTEGRATIONS.",
+		ConflictsWith: []string{"from_replica"},
+	},
+	"allowed_accounts": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		Description:   "Accounts the group is replicated to, as organization.account in upper case, e.g. MYORG.ACCOUNT2. Required unless from_replica is set.",
+		ConflictsWith: []string{"from_replica"},
+	},
+	"ignore_edition_check": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Allows replicating the group to accounts on lower editions. Only applies when accounts are added to allowed_accounts; changing it alone doesn't alter the group.",
+	},
+	"replication_schedule": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Description:   "Schedule refreshing the secondary groups, e.g. 10 MINUTE or USING CRON 0 0 * * * UTC.",
+		ConflictsWith: []string{"from_replica"},
+	},
+	"from_replica": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specify a fully-qualified path to a group to create a replica from, i.e. organization.account.group. The group is created as a secondary group of it, in the account it is replicated to.",
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^.]+\.[^.]+\.[^.]+$`), "from_replica must be organization.account.group"),
+	},
+}
+
+// FailoverGroup returns a pointer to the resource representing a failover group
+func FailoverGroup() *schema.Resource {
+	return failoverGroupResource(snowflake.FailoverGroup)
+}
+
+// ReplicationGroup returns a pointer to the resource representing a replication group
+func ReplicationGroup() *schema.Resource {
+	return failoverGroupResource(snowflake.ReplicationGroup)
+}
+
+func failoverGroupResource(builder func(string) *snowflake.FailoverGroupBuilder) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateFailoverGroup(builder),
+		ReadContext:   ReadFailoverGroup(builder),
+		UpdateContext: UpdateFailoverGroup(builder),
+		DeleteContext: DeleteFailoverGroup(builder),
+
+		Schema: failoverGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// only primary groups can be renamed
+		CustomizeDiff: customdiff.ForceNewIf("name", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			_, isSecondary := d.GetOk("from_replica")
+			return isSecondary && d.HasChange("name")
+		}),
+	}
+}
+
+// CreateFailoverGroup returns a schema.CreateContextFunc for the groups of builder
+func CreateFailoverGroup(builder func(string) *snowflake.FailoverGroupBuilder) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		name := d.Get("name").(string)
+		fb := builder(name)
+
+		var q string
+		if v, ok := d.GetOk("from_replica"); ok {
+			var err error
+			q, err = fb.CreateReplica(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			objectTypes := sortedStrings(d.Get("object_types").(*schema.Set))
+			allowedAccounts := sortedStrings(d.Get("allowed_accounts").(*schema.Set))
+			if len(objectTypes) == 0 || len(allowedAccounts) == 0 {
+				return diag.Errorf("object_types and allowed_accounts are required to create group %v unless from_replica is set", name)
+			}
+			fb.WithObjectTypes(objectTypes).
+				WithAllowedDatabases(sortedStrings(d.Get("allowed_databases").(*schema.Set))).
+				WithAllowedShares(sortedStrings(d.Get("allowed_shares").(*schema.Set))).
+				WithAllowedIntegrationTypes(sortedStrings(d.Get("allowed_integration_types").(*schema.Set))).
+				WithAllowedAccounts(allowedAccounts).
+				WithIgnoreEditionCheck(d.Get("ignore_edition_check").(bool)).
+				WithReplicationSchedule(d.Get("replication_schedule").(string))
+			q = fb.Create()
+		}
+
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error creating group %v", name))
+		}
+
+		d.SetId(name)
+
+		return ReadFailoverGroup(builder)(ctx, d, meta)
+	}
+}
+
+// ReadFailoverGroup returns a schema.ReadContextFunc for the groups of builder
+func ReadFailoverGroup(builder func(string) *snowflake.FailoverGroupBuilder) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		name := d.Id()
+		fb := builder(name)
+
+		rows, err := snowflake.Query(ctx, db, fb.Show())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "unable to list groups to read %v", name))
+		}
+		defer rows.Close()
+
+		groups, err := snowflake.ScanFailoverGroups(rows)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "unable to scan groups to read %v", name))
+		}
+
+		var account string
+		err = snowflake.QueryRow(ctx, db, snowflake.SelectCurrentOrganizationAccount()).Scan(&account)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "unable to read the current account to read group %v", name))
+		}
+
+		// the groups are listed once per account holding them, so pick the
+		// primary or the replica of the configured primary held by the current
+		// account
+		primary, isSecondary := d.GetOk("from_replica")
+		found := -1
+		for i, g := range groups {
+			if g.Name.String != name || g.IsPrimary.Bool == isSecondary || !strings.EqualFold(g.Account(), account) {
+				continue
+			}
+			if isSecondary && !strings.EqualFold(g.Primary.String, primary.(string)) {
+				continue
+			}
+			found = i
+			break
+		}
+		if found < 0 {
+			// If not found, mark resource to be removed from statefile during apply or refresh
+			log.Printf("[DEBUG] group (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		group := groups[found]
+
+		err = d.Set("name", group.Name.String)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// secondary groups are configured by their primary
+		if isSecondary {
+			return nil
+		}
+
+		objectTypes := group.GetObjectTypes()
+		allowedDatabases, allowedShares := []string{}, []string{}
+		for _, t := range objectTypes {
+			switch t {
+			case "DATABASES":
+				allowedDatabases, err = readFailoverGroupObjects(ctx, db, fb.ShowDatabases())
+			case "SHARES":
+				allowedShares, err = readFailoverGroupObjects(ctx, db, fb.ShowShares())
+			}
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "unable to read %v of group %v", strings.ToLower(t), name))
+			}
+		}
+
+		toSet := map[string]interface{}{
+			"object_types":              objectTypes,
+			"allowed_databases":         allowedDatabases,
+			"allowed_shares":            allowedShares,
+			"allowed_integration_types": group.GetAllowedIntegrationTypes(),
+			"allowed_accounts":          group.GetAllowedAccounts(),
+			"replication_schedule":      group.ReplicationSchedule.String,
+		}
+		for key, val := range toSet {
+			err = d.Set(key, val) //lintignore:R001
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+}
+
+// readFailoverGroupObjects returns the names of the objects listed by a SHOW
+// DATABASES or SHOW SHARES query in a group
+func readFailoverGroupObjects(ctx context.Context, db *sql.DB, q string) ([]string, error) {
+	rows, err := snowflake.Query(ctx, db, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return snowflake.ScanFailoverGroupObjects(rows)
+}
+
+// UpdateFailoverGroup returns a schema.UpdateContextFunc for the groups of builder
+func UpdateFailoverGroup(builder func(string) *snowflake.FailoverGroupBuilder) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		fb := builder(d.Id())
+
+		if name := d.Get("name").(string); name != d.Id() {
+			err := snowflake.Exec(ctx, db, fb.Rename(name))
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error renaming group %v to %v", d.Id(), name))
+			}
+			d.SetId(name)
+			fb = builder(name)
+		}
+
+		// secondary groups are configured by their primary
+		if _, ok := d.GetOk("from_replica"); ok {
+			return ReadFailoverGroup(builder)(ctx, d, meta)
+		}
+
+		// objects are removed before their type is, and added after it is
+		removals, additions := []string{}, []string{}
+		if d.HasChange("allowed_databases") {
+			removed, added := changedStrings(d, "allowed_databases")
+			if len(removed) > 0 {
+				removals = append(removals, fb.RemoveAllowedDatabases(removed))
+			}
+			if len(added) > 0 {
+				additions = append(additions, fb.AddAllowedDatabases(added))
+			}
+		}
+		if d.HasChange("allowed_shares") {
+			removed, added := changedStrings(d, "allowed_shares")
+			if len(removed) > 0 {
+				removals = append(removals, fb.RemoveAllowedShares(removed))
+			}
+			if len(added) > 0 {
+				additions = append(additions, fb.AddAllowedShares(added))
+			}
+		}
+		if d.HasChange("allowed_accounts") {
+			removed, added := changedStrings(d, "allowed_accounts")
+			if len(removed) > 0 {
+				removals = append(removals, fb.RemoveAllowedAccounts(removed))
+			}
+			if len(added) > 0 {
+				additions = append(additions, fb.AddAllowedAccounts(added, d.Get("ignore_edition_check").(bool)))
+			}
+		}
+
+		stmts := removals
+		if d.HasChange("object_types") {
+			stmts = append(stmts, fb.ChangeObjectTypes(sortedStrings(d.Get("object_types").(*schema.Set))))
+		}
+		if d.HasChange("allowed_integration_types") {
+			// the integration types are dropped with the INTEGRATIONS object type
+			if types := sortedStrings(d.Get("allowed_integration_types").(*schema.Set)); len(types) > 0 {
+				stmts = append(stmts, fb.ChangeAllowedIntegrationTypes(types))
+			} else if d.Get("object_types").(*schema.Set).Contains("INTEGRATIONS") {
+				stmts = append(stmts, fb.RemoveAllowedIntegrationTypes())
+			}
+		}
+		stmts = append(stmts, additions...)
+		if d.HasChange("replication_schedule") {
+			if s := d.Get("replication_schedule").(string); s != "" {
+				stmts = append(stmts, fb.ChangeReplicationSchedule(s))
+			} else {
+				stmts = append(stmts, fb.RemoveReplicationSchedule())
+			}
+		}
+
+		for _, q := range stmts {
+			err := snowflake.Exec(ctx, db, q)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error updating group %v", d.Id()))
+			}
+		}
+
+		return ReadFailoverGroup(builder)(ctx, d, meta)
+	}
+}
+
+// changedStrings returns the sorted strings removed from and added to the set
+// attribute key
+func changedStrings(d *schema.ResourceData, key string) (removed []string, added []string) {
+	o, n := d.GetChange(key)
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	return sortedStrings(os.Difference(ns)), sortedStrings(ns.Difference(os))
+}
+
+// DeleteFailoverGroup returns a schema.DeleteContextFunc for the groups of builder
+func DeleteFailoverGroup(builder func(string) *snowflake.FailoverGroupBuilder) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		db := meta.(*sql.DB)
+		name := d.Id()
+
+		err := snowflake.Exec(ctx, db, builder(name).Drop())
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error deleting group %v", name))
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
@@ -0,0 +1,288 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverGroup(t *testing.T) {
+	r := require.New(t)
+	err := resources.FailoverGroup().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestReplicationGroup(t *testing.T) {
+	r := require.New(t)
+	err := resources.ReplicationGroup().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestFailoverGroupCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                      "good_name",
+		"object_types":              []interface{}{"ROLES", "DATABASES", "SHARES", "INTEGRATIONS"},
+		"allowed_databases":         []interface{}{"db2", "db1"},
+		"allowed_shares":            []interface{}{"share1"},
+		"allowed_integration_types": []interface{}{"SECURITY INTEGRATIONS"},
+		"allowed_accounts":          []interface{}{"MYORG.ACCOUNT2"},
+		"replication_schedule":      "10 MINUTE",
+	}
+	d := failoverGroup(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE FAILOVER GROUP "good_name" OBJECT_TYPES = DATABASES, INTEGRATIONS, ROLES, SHARES ALLOWED_DATABASES = "db1", "db2" ALLOWED_SHARES = "share1" ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS ALLOWED_ACCOUNTS = MYORG.ACCOUNT2 REPLICATION_SCHEDULE = '10 MINUTE'$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadFailoverGroup(mock)
+		diags := resources.CreateFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("good_name", d.Id())
+	})
+}
+
+func TestFailoverGroupCreateWithoutObjectTypes(t *testing.T) {
+	r := require.New(t)
+
+	d := failoverGroup(t, "", map[string]interface{}{"name": "good_name", "allowed_accounts": []interface{}{"MYORG.ACCOUNT2"}})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.CreateFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.True(diags.HasError())
+	})
+}
+
+func TestReplicationGroupCreateFromReplica(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "good_name",
+		"from_replica": "MYORG.ACCOUNT1.good_name",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ReplicationGroup().Schema, in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE REPLICATION GROUP "good_name" AS REPLICA OF MYORG.ACCOUNT1."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows(failoverGroupColumns).
+			AddRow("PUBLIC", "AWS_US_WEST_2", "", "ACCOUNT1", "good_name", "REPLICATION", "", "true", "MYORG.ACCOUNT1.good_name", "WAREHOUSES", "", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "AB12345", "10 MINUTE", nil, nil, "ACCOUNTADMIN").
+			AddRow("PUBLIC", "AWS_US_EAST_1", "", "ACCOUNT2", "good_name", "REPLICATION", "", "false", "MYORG.ACCOUNT1.good_name", "WAREHOUSES", "", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "CD12345", "10 MINUTE", "STARTED", "", "ACCOUNTADMIN")
+		mock.ExpectQuery(`^SHOW REPLICATION GROUPS$`).WillReturnRows(rows)
+		expectReadCurrentOrganizationAccount(mock, "MYORG.ACCOUNT2")
+		diags := resources.CreateFailoverGroup(snowflake.ReplicationGroup)(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("good_name", d.Id())
+		// the secondary group doesn't own the configuration of the primary
+		r.Equal(0, d.Get("object_types.#"))
+	})
+}
+
+var failoverGroupColumns = []string{"region_group", "snowflake_region", "created_on", "account_name", "name", "type", "comment", "is_primary", "primary", "object_types", "allowed_integration_types", "allowed_accounts", "organization_name", "account_locator", "replication_schedule", "secondary_state", "next_scheduled_refresh", "owner"}
+
+func expectReadFailoverGroup(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows(failoverGroupColumns).
+		AddRow("PUBLIC", "AWS_US_WEST_2", "", "ACCOUNT1", "other_group", "FAILOVER", "", "true", "MYORG.ACCOUNT1.other_group", "ROLES", "", "MYORG.ACCOUNT1, MYORG.ACCOUNT3", "MYORG", "AB12345", "", nil, nil, "ACCOUNTADMIN").
+		AddRow("PUBLIC", "AWS_US_WEST_2", "", "ACCOUNT1", "good_name", "FAILOVER", "", "true", "MYORG.ACCOUNT1.good_name", "DATABASES, INTEGRATIONS, ROLES, SHARES", "SECURITY INTEGRATIONS", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "AB12345", "10 MINUTE", nil, nil, "ACCOUNTADMIN")
+	mock.ExpectQuery(`^SHOW FAILOVER GROUPS$`).WillReturnRows(rows)
+	expectReadCurrentOrganizationAccount(mock, "MYORG.ACCOUNT1")
+
+	databases := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time"}).
+		AddRow("", "db1", "N", "N", "", "SYSADMIN", "", "", "1").
+		AddRow("", "db2", "N", "N", "", "SYSADMIN", "", "", "1")
+	mock.ExpectQuery(`^SHOW DATABASES IN FAILOVER GROUP "good_name"$`).WillReturnRows(databases)
+
+	shares := sqlmock.NewRows([]string{"created_on", "kind", "name", "database_name", "to", "owner", "comment"}).
+		AddRow("", "OUTBOUND", "MYORG.ACCOUNT1.share1", "db1", "", "ACCOUNTADMIN", "")
+	mock.ExpectQuery(`^SHOW SHARES IN FAILOVER GROUP "good_name"$`).WillReturnRows(shares)
+}
+
+func expectReadCurrentOrganizationAccount(mock sqlmock.Sqlmock, account string) {
+	rows := sqlmock.NewRows([]string{"account"}).AddRow(account)
+	mock.ExpectQuery(`^SELECT CURRENT_ORGANIZATION_NAME\(\) \|\| '\.' \|\| CURRENT_ACCOUNT_NAME\(\) AS "account"$`).WillReturnRows(rows)
+}
+
+func TestFailoverGroupFromReplicaMustBeQualified(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "test_group",
+		"from_replica": "test_group",
+	}
+	diags := resources.FailoverGroup().Validate(terraform.NewResourceConfigRaw(in))
+	r.True(diags.HasError())
+}
+
+func TestFailoverGroupRead(t *testing.T) {
+	r := require.New(t)
+
+	d := failoverGroup(t, "good_name", map[string]interface{}{"name": "good_name"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadFailoverGroup(mock)
+		diags := resources.ReadFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal(4, d.Get("object_types.#"))
+		r.ElementsMatch([]interface{}{"db1", "db2"}, d.Get("allowed_databases").(*schema.Set).List())
+		r.Equal([]interface{}{"share1"}, d.Get("allowed_shares").(*schema.Set).List())
+		r.Equal([]interface{}{"SECURITY INTEGRATIONS"}, d.Get("allowed_integration_types").(*schema.Set).List())
+		r.Equal([]interface{}{"MYORG.ACCOUNT2"}, d.Get("allowed_accounts").(*schema.Set).List())
+		r.Equal("10 MINUTE", d.Get("replication_schedule"))
+
+		// Test when resource is not found, checking if state will be empty
+		r.NotEmpty(d.State())
+		mock.ExpectQuery(`^SHOW FAILOVER GROUPS$`).WillReturnRows(sqlmock.NewRows(failoverGroupColumns))
+		expectReadCurrentOrganizationAccount(mock, "MYORG.ACCOUNT1")
+		diags = resources.ReadFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.State())
+	})
+}
+
+func TestFailoverGroupUpdate(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.FailoverGroup()
+	state := &terraform.InstanceState{
+		ID: "old_name",
+		Attributes: map[string]string{
+			"name":                 "old_name",
+			"object_types.#":       "2",
+			"object_types.0":       "DATABASES",
+			"object_types.1":       "ROLES",
+			"allowed_databases.#":  "2",
+			"allowed_databases.0":  "db1",
+			"allowed_databases.1":  "db3",
+			"allowed_accounts.#":   "1",
+			"allowed_accounts.0":   "MYORG.ACCOUNT3",
+			"ignore_edition_check": "false",
+			"replication_schedule": "",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                      "good_name",
+		"object_types":              []interface{}{"ROLES", "DATABASES", "SHARES", "INTEGRATIONS"},
+		"allowed_databases":         []interface{}{"db2", "db1"},
+		"allowed_shares":            []interface{}{"share1"},
+		"allowed_integration_types": []interface{}{"SECURITY INTEGRATIONS"},
+		"allowed_accounts":          []interface{}{"MYORG.ACCOUNT2"},
+		"replication_schedule":      "10 MINUTE",
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	r.False(diff.RequiresNew())
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "old_name" RENAME TO "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" REMOVE "db3" FROM ALLOWED_DATABASES$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" REMOVE MYORG.ACCOUNT3 FROM ALLOWED_ACCOUNTS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" SET OBJECT_TYPES = DATABASES, INTEGRATIONS, ROLES, SHARES$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" SET ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" ADD "db2" TO ALLOWED_DATABASES$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" ADD "share1" TO ALLOWED_SHARES$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" ADD MYORG.ACCOUNT2 TO ALLOWED_ACCOUNTS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" SET REPLICATION_SCHEDULE = '10 MINUTE'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadFailoverGroup(mock)
+		diags := resources.UpdateFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("good_name", d.Id())
+	})
+}
+
+func TestFailoverGroupReadPrimaryOfOtherAccount(t *testing.T) {
+	r := require.New(t)
+
+	d := failoverGroup(t, "good_name", map[string]interface{}{"name": "good_name"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the primary of another account with the same name isn't this group
+		rows := sqlmock.NewRows(failoverGroupColumns).
+			AddRow("PUBLIC", "AWS_US_WEST_2", "", "ACCOUNT1", "good_name", "FAILOVER", "", "true", "MYORG.ACCOUNT1.good_name", "ROLES", "", "MYORG.ACCOUNT1, MYORG.ACCOUNT2", "MYORG", "AB12345", "", nil, nil, "ACCOUNTADMIN")
+		mock.ExpectQuery(`^SHOW FAILOVER GROUPS$`).WillReturnRows(rows)
+		expectReadCurrentOrganizationAccount(mock, "MYORG.ACCOUNT2")
+
+		diags := resources.ReadFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.Empty(diags)
+		r.Empty(d.State())
+	})
+}
+
+func TestReplicationGroupRenameSecondary(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.ReplicationGroup()
+	state := &terraform.InstanceState{
+		ID: "old_name",
+		Attributes: map[string]string{
+			"name":                 "old_name",
+			"from_replica":         "MYORG.ACCOUNT1.old_name",
+			"ignore_edition_check": "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":         "good_name",
+		"from_replica": "MYORG.ACCOUNT1.old_name",
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	r.True(diff.RequiresNew())
+	r.True(diff.Attributes["name"].RequiresNew)
+}
+
+func TestFailoverGroupUpdateRemoveIntegrationTypes(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.FailoverGroup()
+	state := &terraform.InstanceState{
+		ID: "good_name",
+		Attributes: map[string]string{
+			"name":                        "good_name",
+			"object_types.#":              "1",
+			"object_types.0":              "INTEGRATIONS",
+			"allowed_integration_types.#": "1",
+			"allowed_integration_types.0": "SECURITY INTEGRATIONS",
+			"allowed_accounts.#":          "1",
+			"allowed_accounts.0":          "MYORG.ACCOUNT2",
+			"ignore_edition_check":        "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "good_name",
+		"object_types":     []interface{}{"INTEGRATIONS"},
+		"allowed_accounts": []interface{}{"MYORG.ACCOUNT2"},
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER FAILOVER GROUP "good_name" UNSET ALLOWED_INTEGRATION_TYPES$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadFailoverGroup(mock)
+		diags := resources.UpdateFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestFailoverGroupDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := failoverGroup(t, "drop_it", map[string]interface{}{"name": "drop_it"})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^DROP FAILOVER GROUP "drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diags := resources.DeleteFailoverGroup(snowflake.FailoverGroup)(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -66,9 +69,9 @@ var fileFormatGrantSchema = map[string]*schema.Schema{
 func FileFormatGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateFileFormatGrant,
-			Read:   ReadFileFormatGrant,
-			Delete: DeleteFileFormatGrant,
+			CreateContext: CreateFileFormatGrant,
+			ReadContext:   ReadFileFormatGrant,
+			DeleteContext: DeleteFileFormatGrant,
 
 			Schema: fileFormatGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -79,8 +82,8 @@ func FileFormatGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateFileFormatGrant implements schema.CreateFunc
-func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateFileFormatGrant implements schema.CreateContextFunc
+func CreateFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var fileFormatName string
 	if name, ok := d.GetOk("file_format_name"); ok {
 		fileFormatName = name.(string)
@@ -92,10 +95,10 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (fileFormatName == "") && !futureFileFormats {
-		return errors.New("file_format_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("file_format_name must be set unless on_future is true."))
 	}
 	if (fileFormatName != "") && futureFileFormats {
-		return errors.New("file_format_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("file_format_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -105,9 +108,9 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FileFormatGrant(dbName, schemaName, fileFormatName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -119,18 +122,18 @@ func CreateFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadFileFormatGrant(d, meta)
+	return ReadFileFormatGrant(ctx, d, meta)
 }
 
-// ReadFileFormatGrant implements schema.ReadFunc
-func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadFileFormatGrant implements schema.ReadContextFunc
+func ReadFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -139,11 +142,11 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureFileFormatsEnabled := false
 	if fileFormatName == "" {
@@ -151,19 +154,19 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("file_format_name", fileFormatName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureFileFormatsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -173,14 +176,17 @@ func ReadFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FileFormatGrant(dbName, schemaName, fileFormatName)
 	}
 
-	return readGenericGrant(d, meta, fileFormatGrantSchema, builder, futureFileFormatsEnabled, validFileFormatPrivileges)
+	if err := readGenericGrant(ctx, d, meta, fileFormatGrantSchema, builder, futureFileFormatsEnabled, validFileFormatPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteFileFormatGrant implements schema.DeleteFunc
-func DeleteFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteFileFormatGrant implements schema.DeleteContextFunc
+func DeleteFileFormatGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -194,5 +200,8 @@ func DeleteFileFormatGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.FileFormatGrant(dbName, schemaName, fileFormatName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
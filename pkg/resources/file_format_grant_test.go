@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,8 +38,8 @@ func TestFileFormatGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON FILE FORMAT "test-db"."PUBLIC"."test-file-format" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON FILE FORMAT "test-db"."PUBLIC"."test-file-format" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFileFormatGrant(mock)
-		err := resources.CreateFileFormatGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateFileFormatGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -58,8 +59,8 @@ func TestFileFormatGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadFileFormatGrant(mock)
-		err := resources.ReadFileFormatGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadFileFormatGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -101,8 +102,8 @@ func TestFutureFileFormatGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE FILE FORMATS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureFileFormatGrant(mock)
-		err := resources.CreateFileFormatGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateFileFormatGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -125,8 +126,8 @@ func TestFutureFileFormatGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE FILE FORMATS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureFileFormatDatabaseGrant(mock)
-		err := resources.CreateFileFormatGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateFileFormatGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -101,9 +103,9 @@ var functionGrantSchema = map[string]*schema.Schema{
 func FunctionGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateFunctionGrant,
-			Read:   ReadFunctionGrant,
-			Delete: DeleteFunctionGrant,
+			CreateContext: CreateFunctionGrant,
+			ReadContext:   ReadFunctionGrant,
+			DeleteContext: DeleteFunctionGrant,
 
 			Schema: functionGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -114,8 +116,8 @@ func FunctionGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateFunctionGrant implements schema.CreateFunc
-func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateFunctionGrant implements schema.CreateContextFunc
+func CreateFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		functionName      string
 		arguments         []interface{}
@@ -128,12 +130,12 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		if args, ok := d.GetOk("arguments"); ok {
 			arguments = args.([]interface{})
 		} else {
-			return errors.New("arguments must be set when specifying function_name.")
+			return diag.FromErr(errors.New("arguments must be set when specifying function_name."))
 		}
 		if ret, ok := d.GetOk("return_type"); ok {
 			returnType = strings.ToUpper(ret.(string))
 		} else {
-			return errors.New("return_type must be set when specifying function_name.")
+			return diag.FromErr(errors.New("return_type must be set when specifying function_name."))
 		}
 	}
 	dbName := d.Get("database_name").(string)
@@ -143,10 +145,10 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (functionName == "") && !futureFunctions {
-		return errors.New("function_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("function_name must be set unless on_future is true."))
 	}
 	if (functionName != "") && futureFunctions {
-		return errors.New("function_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("function_name must be empty if on_future is true."))
 	}
 
 	if functionName != "" {
@@ -162,9 +164,9 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FunctionGrant(dbName, schemaName, functionName, argumentTypes)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -176,15 +178,15 @@ func CreateFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadFunctionGrant(d, meta)
+	return ReadFunctionGrant(ctx, d, meta)
 }
 
-// ReadFunctionGrant implements schema.ReadFunc
-func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadFunctionGrant implements schema.ReadContextFunc
+func ReadFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		functionName  string
 		returnType    string
@@ -193,7 +195,7 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	)
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -202,11 +204,11 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureFunctionsEnabled := false
 	if functionSignature == "" {
@@ -214,7 +216,7 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		functionSignatureMap, err := parseCallableObjectName(functionSignature)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		functionName = functionSignatureMap["callableName"].(string)
 		returnType = functionSignatureMap["returnType"].(string)
@@ -223,27 +225,27 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("function_name", functionName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("arguments", arguments)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("return_type", returnType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureFunctionsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -253,14 +255,17 @@ func ReadFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.FunctionGrant(dbName, schemaName, functionName, argumentTypes)
 	}
 
-	return readGenericGrant(d, meta, functionGrantSchema, builder, futureFunctionsEnabled, validFunctionPrivileges)
+	if err := readGenericGrant(ctx, d, meta, functionGrantSchema, builder, futureFunctionsEnabled, validFunctionPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteFunctionGrant implements schema.DeleteFunc
-func DeleteFunctionGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteFunctionGrant implements schema.DeleteContextFunc
+func DeleteFunctionGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -273,11 +278,14 @@ func DeleteFunctionGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		functionSignatureMap, err := parseCallableObjectName(grantID.ObjectName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		functionName := functionSignatureMap["callableName"].(string)
 		argumentTypes := functionSignatureMap["argumentTypes"].([]string)
 		builder = snowflake.FunctionGrant(dbName, schemaName, functionName, argumentTypes)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
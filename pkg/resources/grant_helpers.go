@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -37,34 +38,8 @@ const (
 	grantIDDelimiter = '|'
 )
 
-// currentGrant represents a generic grant of a privilege from a grant (the target) to a
-// grantee. This type can be used in conjunction with github.com/jmoiron/sqlx to
-// build a nice go representation of a grant
-type currentGrant struct {
-	CreatedOn   time.Time `db:"created_on"`
-	Privilege   string    `db:"privilege"`
-	GrantType   string    `db:"granted_on"`
-	GrantName   string    `db:"name"`
-	GranteeType string    `db:"granted_to"`
-	GranteeName string    `db:"grantee_name"`
-	GrantOption bool      `db:"grant_option"`
-	GrantedBy   string    `db:"granted_by"`
-}
-
-// futureGrant represents the columns in the response from `SHOW FUTURE GRANTS
-// IN SCHEMA...` and can be used in conjunction with sqlx.
-type futureGrant struct {
-	CreatedOn   time.Time `db:"created_on"`
-	Privilege   string    `db:"privilege"`
-	GrantType   string    `db:"grant_on"`
-	GrantName   string    `db:"name"`
-	GranteeType string    `db:"grant_to"`
-	GranteeName string    `db:"grantee_name"`
-	GrantOption bool      `db:"grant_option"`
-}
-
-// grant is simply the least common denominator of fields in currentGrant and
-// futureGrant.
+// grant is simply the least common denominator of fields in snowflake.CurrentGrant
+// and snowflake.FutureGrant.
 type grant struct {
 	CreatedOn   time.Time
 	Privilege   string
@@ -134,6 +109,7 @@ func grantIDFromString(stringID string) (*grantID, error) {
 
 // createGenericGrantRolesAndShares will create generic grants for a set of roles and shares
 func createGenericGrantRolesAndShares(
+	ctx context.Context,
 	meta interface{},
 	builder snowflake.GrantBuilder,
 	priv string,
@@ -143,14 +119,14 @@ func createGenericGrantRolesAndShares(
 ) error {
 	db := meta.(*sql.DB)
 	for _, role := range roles {
-		err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption))
+		err := snowflake.Exec(ctx, db, builder.Role(role).Grant(priv, grantOption))
 		if err != nil {
 			return err
 		}
 	}
 
 	for _, share := range shares {
-		err := snowflake.Exec(db, builder.Share(share).Grant(priv, grantOption))
+		err := snowflake.Exec(ctx, db, builder.Share(share).Grant(priv, grantOption))
 		if err != nil {
 			return err
 		}
@@ -158,12 +134,13 @@ func createGenericGrantRolesAndShares(
 	return nil
 }
 
-func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
+func createGenericGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	roles, shares := expandRolesAndShares(d)
 
 	return createGenericGrantRolesAndShares(
+		ctx,
 		meta,
 		builder,
 		priv,
@@ -174,6 +151,7 @@ func createGenericGrant(d *schema.ResourceData, meta interface{}, builder snowfl
 }
 
 func readGenericGrant(
+	ctx context.Context,
 	d *schema.ResourceData,
 	meta interface{},
 	schema map[string]*schema.Schema,
@@ -184,9 +162,9 @@ func readGenericGrant(
 	var grants []*grant
 	var err error
 	if futureObjects {
-		grants, err = readGenericFutureGrants(db, builder)
+		grants, err = readGenericFutureGrants(ctx, db, builder)
 	} else {
-		grants, err = readGenericCurrentGrants(db, builder)
+		grants, err = readGenericCurrentGrants(ctx, db, builder)
 	}
 	if err != nil {
 		// HACK HACK: If the object doesn't exist or not authorized then we can assume someone deleted it
@@ -283,9 +261,9 @@ func readGenericGrant(
 	return nil
 }
 
-func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
+func readGenericCurrentGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
 	stmt := builder.Show()
-	rows, err := snowflake.Query(db, stmt)
+	rows, err := snowflake.Query(ctx, db, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +271,7 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 
 	var grants []*grant
 	for rows.Next() {
-		currentGrant := &currentGrant{}
+		currentGrant := &snowflake.CurrentGrant{}
 		err := rows.StructScan(currentGrant)
 		if err != nil {
 			return nil, err
@@ -320,11 +298,11 @@ func readGenericCurrentGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gr
 	return grants, nil
 }
 
-func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
+func readGenericFutureGrants(ctx context.Context, db *sql.DB, builder snowflake.GrantBuilder) ([]*grant, error) {
 	conn := sqlx.NewDb(db, "snowflake")
 
 	stmt := builder.Show()
-	rows, err := conn.Queryx(stmt)
+	rows, err := conn.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -332,7 +310,7 @@ func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gra
 
 	var grants []*grant
 	for rows.Next() {
-		futureGrant := &futureGrant{}
+		futureGrant := &snowflake.FutureGrant{}
 		err := rows.StructScan(futureGrant)
 		if err != nil {
 			return nil, err
@@ -355,6 +333,7 @@ func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gra
 // Deletes specific roles and shares from a grant
 // Does not modify TF remote state
 func deleteGenericGrantRolesAndShares(
+	ctx context.Context,
 	meta interface{},
 	builder snowflake.GrantBuilder,
 	priv string,
@@ -364,14 +343,14 @@ func deleteGenericGrantRolesAndShares(
 	db := meta.(*sql.DB)
 
 	for _, role := range roles {
-		err := snowflake.ExecMulti(db, builder.Role(role).Revoke(priv))
+		err := snowflake.ExecMulti(ctx, db, builder.Role(role).Revoke(priv))
 		if err != nil {
 			return err
 		}
 	}
 
 	for _, share := range shares {
-		err := snowflake.ExecMulti(db, builder.Share(share).Revoke(priv))
+		err := snowflake.ExecMulti(ctx, db, builder.Share(share).Revoke(priv))
 		if err != nil {
 			return err
 		}
@@ -379,10 +358,10 @@ func deleteGenericGrantRolesAndShares(
 	return nil
 }
 
-func deleteGenericGrant(d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
+func deleteGenericGrant(ctx context.Context, d *schema.ResourceData, meta interface{}, builder snowflake.GrantBuilder) error {
 	priv := d.Get("privilege").(string)
 	roles, shares := expandRolesAndShares(d)
-	err := deleteGenericGrantRolesAndShares(meta, builder, priv, roles, shares)
+	err := deleteGenericGrantRolesAndShares(ctx, meta, builder, priv, roles, shares)
 	if err != nil {
 		return err
 	}
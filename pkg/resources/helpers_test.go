@@ -279,3 +279,27 @@ func procedureGrant(t *testing.T, id string, params map[string]interface{}) *sch
 	d.SetId(id)
 	return d
 }
+
+func accountParameter(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.AccountParameter().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func objectParameter(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.ObjectParameter().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func failoverGroup(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.FailoverGroup().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
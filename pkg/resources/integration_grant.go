@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -45,9 +48,9 @@ var integrationGrantSchema = map[string]*schema.Schema{
 func IntegrationGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateIntegrationGrant,
-			Read:   ReadIntegrationGrant,
-			Delete: DeleteIntegrationGrant,
+			CreateContext: CreateIntegrationGrant,
+			ReadContext:   ReadIntegrationGrant,
+			DeleteContext: DeleteIntegrationGrant,
 
 			Schema: integrationGrantSchema,
 		},
@@ -55,16 +58,16 @@ func IntegrationGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateIntegrationGrant implements schema.CreateFunc
-func CreateIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateIntegrationGrant implements schema.CreateContextFunc
+func CreateIntegrationGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	w := d.Get("integration_name").(string)
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	builder := snowflake.IntegrationGrant(w)
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -74,49 +77,55 @@ func CreateIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadIntegrationGrant(d, meta)
+	return ReadIntegrationGrant(ctx, d, meta)
 }
 
-// ReadIntegrationGrant implements schema.ReadFunc
-func ReadIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadIntegrationGrant implements schema.ReadContextFunc
+func ReadIntegrationGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 	priv := grantID.Privilege
 
 	err = d.Set("integration_name", w)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.IntegrationGrant(w)
 
-	return readGenericGrant(d, meta, integrationGrantSchema, builder, false, validIntegrationPrivileges)
+	if err := readGenericGrant(ctx, d, meta, integrationGrantSchema, builder, false, validIntegrationPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteIntegrationGrant implements schema.DeleteFunc
-func DeleteIntegrationGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteIntegrationGrant implements schema.DeleteContextFunc
+func DeleteIntegrationGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 
 	builder := snowflake.IntegrationGrant(w)
 
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
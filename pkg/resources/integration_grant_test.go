@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,8 +38,8 @@ func TestIntegrationGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON INTEGRATION "test-integration" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON INTEGRATION "test-integration" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadIntegrationGrant(mock)
-		err := resources.CreateIntegrationGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateIntegrationGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -56,8 +57,8 @@ func TestIntegrationGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadIntegrationGrant(mock)
-		err := resources.ReadIntegrationGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadIntegrationGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
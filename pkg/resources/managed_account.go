@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	snowflakeValidation "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -87,9 +89,9 @@ var managedAccountSchema = map[string]*schema.Schema{
 // ManagedAccount returns a pointer to the resource representing a managed account
 func ManagedAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateManagedAccount,
-		Read:   ReadManagedAccount,
-		Delete: DeleteManagedAccount,
+		CreateContext: CreateManagedAccount,
+		ReadContext:   ReadManagedAccount,
+		DeleteContext: DeleteManagedAccount,
 
 		Schema: managedAccountSchema,
 		Importer: &schema.ResourceImporter{
@@ -98,33 +100,33 @@ func ManagedAccount() *schema.Resource {
 	}
 }
 
-// CreateManagedAccount implements schema.CreateFunc
-func CreateManagedAccount(d *schema.ResourceData, meta interface{}) error {
+// CreateManagedAccount implements schema.CreateContextFunc
+func CreateManagedAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return CreateResource(
 		"this does not seem to be used",
 		managedAccountProperties,
 		managedAccountSchema,
 		snowflake.ManagedAccount,
 		initialReadManagedAccount,
-	)(d, meta)
+	)(ctx, d, meta)
 }
 
 // initialReadManagedAccount is used for the first read, since the locator takes
 // some time to appear. This is currently implemented as a sleep. @TODO actually
 // wait until the locator is generated.
-func initialReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
+func initialReadManagedAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] sleeping to give the locator a chance to be generated")
 	time.Sleep(10 * time.Second)
-	return ReadManagedAccount(d, meta)
+	return ReadManagedAccount(ctx, d, meta)
 }
 
-// ReadManagedAccount implements schema.ReadFunc
-func ReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
+// ReadManagedAccount implements schema.ReadContextFunc
+func ReadManagedAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.ManagedAccount(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 	a, err := snowflake.ScanManagedAccount(row)
 
 	if err == sql.ErrNoRows {
@@ -134,71 +136,56 @@ func ReadManagedAccount(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", a.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("cloud", a.Cloud.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("region", a.Region.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("locator", a.Locator.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("created_on", a.CreatedOn.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("url", a.Url.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if a.IsReader {
 		err = d.Set("type", "READER")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("Unable to determine the account type")
+		return diag.FromErr(fmt.Errorf("Unable to determine the account type"))
 	}
 
 	err = d.Set("comment", a.Comment.String)
 
-	return err
-}
-
-// DeleteManagedAccount implements schema.DeleteFunc
-func DeleteManagedAccount(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("this does not seem to be used", snowflake.ManagedAccount)(d, meta)
-}
-
-// ManagedAccountExists implements schema.ExistsFunc
-func ManagedAccountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.ManagedAccount(id).Show()
-	rows, err := db.Query(stmt)
 	if err != nil {
-		return false, err
+		return diag.FromErr(err)
 	}
-	defer rows.Close()
+	return nil
+}
 
-	if rows.Next() {
-		return true, nil
-	}
-	return false, nil
+// DeleteManagedAccount implements schema.DeleteContextFunc
+func DeleteManagedAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("this does not seem to be used", snowflake.ManagedAccount)(ctx, d, meta)
 }
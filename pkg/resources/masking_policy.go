@@ -2,12 +2,14 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -109,10 +111,10 @@ func maskingPolicyIDFromString(stringID string) (*maskingPolicyID, error) {
 // MaskingPolicy returns a pointer to the resource representing a masking policy
 func MaskingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateMaskingPolicy,
-		Read:   ReadMaskingPolicy,
-		Update: UpdateMaskingPolicy,
-		Delete: DeleteMaskingPolicy,
+		CreateContext: CreateMaskingPolicy,
+		ReadContext:   ReadMaskingPolicy,
+		UpdateContext: UpdateMaskingPolicy,
+		DeleteContext: DeleteMaskingPolicy,
 
 		Schema: maskingPolicySchema,
 		Importer: &schema.ResourceImporter{
@@ -121,8 +123,8 @@ func MaskingPolicy() *schema.Resource {
 	}
 }
 
-// CreateMaskingPolicy implements schema.CreateFunc
-func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreateMaskingPolicy implements schema.CreateContextFunc
+func CreateMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
@@ -143,9 +145,9 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating masking policy %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating masking policy %v", name))
 	}
 
 	maskingPolicyID := &maskingPolicyID{
@@ -155,19 +157,19 @@ func CreateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := maskingPolicyID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadMaskingPolicy(d, meta)
+	return ReadMaskingPolicy(ctx, d, meta)
 }
 
-// ReadMaskingPolicy implements schema.ReadFunc
-func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadMaskingPolicy implements schema.ReadContextFunc
+func ReadMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	maskingPolicyID, err := maskingPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := maskingPolicyID.DatabaseName
@@ -178,37 +180,37 @@ func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	showSQL := builder.Show()
 
-	row := snowflake.QueryRow(db, showSQL)
+	row := snowflake.QueryRow(ctx, db, showSQL)
 
 	s, err := snowflake.ScanMaskingPolicies(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", s.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", s.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	descSQL := builder.Describe()
-	rows, err := snowflake.Query(db, descSQL)
+	rows, err := snowflake.Query(ctx, db, descSQL)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -220,37 +222,40 @@ func ReadMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	for rows.Next() {
 		err := rows.Scan(&name, &signature, &returnType, &body)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("masking_expression", body)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("return_data_type", returnType)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// format in database is `(VAL <data_type>)`
 		valueDataType := strings.TrimSuffix(strings.Split(signature, " ")[1], ")")
 		err = d.Set("value_data_type", valueDataType)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// UpdateMaskingPolicy implements schema.UpdateFunc
-func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdateMaskingPolicy implements schema.UpdateContextFunc
+func UpdateMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	maskingPolicyID, err := maskingPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := maskingPolicyID.DatabaseName
@@ -263,15 +268,15 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 		comment := d.Get("comment")
 		if c := comment.(string); c == "" {
 			q := builder.RemoveComment()
-			err := snowflake.Exec(db, q)
+			err := snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for masking policy on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for masking policy on %v", d.Id()))
 			}
 		} else {
 			q := builder.ChangeComment(c)
-			err := snowflake.Exec(db, q)
+			err := snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for masking policy on %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for masking policy on %v", d.Id()))
 			}
 		}
 	}
@@ -279,21 +284,21 @@ func UpdateMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("masking_expression") {
 		maskingExpression := d.Get("masking_expression")
 		q := builder.ChangeMaskingExpression(maskingExpression.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating masking policy expression on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating masking policy expression on %v", d.Id()))
 		}
 	}
 
-	return ReadMaskingPolicy(d, meta)
+	return ReadMaskingPolicy(ctx, d, meta)
 }
 
-// DeleteMaskingPolicy implements schema.DeleteFunc
-func DeleteMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeleteMaskingPolicy implements schema.DeleteContextFunc
+func DeleteMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	maskingPolicyID, err := maskingPolicyIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := maskingPolicyID.DatabaseName
@@ -302,9 +307,9 @@ func DeleteMaskingPolicy(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.MaskingPolicy(policyName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting masking policy %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting masking policy %v", d.Id()))
 	}
 
 	d.SetId("")
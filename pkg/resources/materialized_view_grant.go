@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -79,9 +82,9 @@ var materializedViewGrantSchema = map[string]*schema.Schema{
 func MaterializedViewGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateMaterializedViewGrant,
-			Read:   ReadMaterializedViewGrant,
-			Delete: DeleteMaterializedViewGrant,
+			CreateContext: CreateMaterializedViewGrant,
+			ReadContext:   ReadMaterializedViewGrant,
+			DeleteContext: DeleteMaterializedViewGrant,
 
 			Schema: materializedViewGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -92,8 +95,8 @@ func MaterializedViewGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateViewGrant implements schema.CreateFunc
-func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateViewGrant implements schema.CreateContextFunc
+func CreateMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var materializedViewName string
 	if name, ok := d.GetOk("materialized_view_name"); ok {
 		materializedViewName = name.(string)
@@ -105,10 +108,10 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (materializedViewName == "") && !futureMaterializedViews {
-		return errors.New("materialized_view_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("materialized_view_name must be set unless on_future is true."))
 	}
 	if (materializedViewName != "") && futureMaterializedViews {
-		return errors.New("materialized_view_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("materialized_view_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -118,9 +121,9 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 		builder = snowflake.MaterializedViewGrant(dbName, schemaName, materializedViewName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -132,18 +135,18 @@ func CreateMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadMaterializedViewGrant(d, meta)
+	return ReadMaterializedViewGrant(ctx, d, meta)
 }
 
-// ReadViewGrant implements schema.ReadFunc
-func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadViewGrant implements schema.ReadContextFunc
+func ReadMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -152,11 +155,11 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureMaterializedViewsEnabled := false
 	if materializedViewName == "" {
@@ -164,19 +167,19 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("materialized_view_name", materializedViewName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureMaterializedViewsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -186,14 +189,17 @@ func ReadMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.MaterializedViewGrant(dbName, schemaName, materializedViewName)
 	}
 
-	return readGenericGrant(d, meta, materializedViewGrantSchema, builder, futureMaterializedViewsEnabled, validMaterializedViewPrivileges)
+	if err := readGenericGrant(ctx, d, meta, materializedViewGrantSchema, builder, futureMaterializedViewsEnabled, validMaterializedViewPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteViewGrant implements schema.DeleteFunc
-func DeleteMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteViewGrant implements schema.DeleteContextFunc
+func DeleteMaterializedViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -207,5 +213,8 @@ func DeleteMaterializedViewGrant(d *schema.ResourceData, meta interface{}) error
 	} else {
 		builder = snowflake.MaterializedViewGrant(dbName, schemaName, materializedViewName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
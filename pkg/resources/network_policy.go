@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -42,10 +44,10 @@ var networkPolicySchema = map[string]*schema.Schema{
 // NetworkPolicy returns a pointer to the resource representing a network policy
 func NetworkPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNetworkPolicy,
-		Read:   ReadNetworkPolicy,
-		Update: UpdateNetworkPolicy,
-		Delete: DeleteNetworkPolicy,
+		CreateContext: CreateNetworkPolicy,
+		ReadContext:   ReadNetworkPolicy,
+		UpdateContext: UpdateNetworkPolicy,
+		DeleteContext: DeleteNetworkPolicy,
 
 		Schema: networkPolicySchema,
 		Importer: &schema.ResourceImporter{
@@ -54,8 +56,8 @@ func NetworkPolicy() *schema.Resource {
 	}
 }
 
-// CreateNetworkPolicy implements schema.CreateFunc
-func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// CreateNetworkPolicy implements schema.CreateContextFunc
+func CreateNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	builder := snowflake.NetworkPolicy(name)
@@ -74,17 +76,17 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating network policy %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating network policy %v", name))
 	}
 	d.SetId(name)
 
-	return ReadNetworkPolicy(d, meta)
+	return ReadNetworkPolicy(ctx, d, meta)
 }
 
-// ReadNetworkPolicy implements schema.ReadFunc
-func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// ReadNetworkPolicy implements schema.ReadContextFunc
+func ReadNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	policyName := d.Id()
 
@@ -93,7 +95,7 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	// There is no way to SHOW a single Network Policy, so we have to read *all* network policies and filter in memory
 	showSql := builder.ShowAllNetworkPolicies()
 
-	rows, err := snowflake.Query(db, showSql)
+	rows, err := snowflake.Query(ctx, db, showSql)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] network policy (%s) not found", d.Id())
@@ -101,12 +103,12 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	allPolicies, err := snowflake.ScanNetworkPolicies(rows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var s *snowflake.NetworkPolicyStruct = nil
@@ -124,19 +126,19 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	descSql := builder.Describe()
-	rows, err = snowflake.Query(db, descSql)
+	rows, err = snowflake.Query(ctx, db, descSql)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -146,27 +148,30 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	for rows.Next() {
 		err := rows.Scan(&name, &value)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if name == "ALLOWED_IP_LIST" {
 			err = d.Set("allowed_ip_list", strings.Split(value, ","))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else if name == "BLOCKED_IP_LIST" {
 			err = d.Set("blocked_ip_list", strings.Split(value, ","))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// UpdateNetworkPolicy implements schema.UpdateFunc
-func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// UpdateNetworkPolicy implements schema.UpdateContextFunc
+func UpdateNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 	builder := snowflake.NetworkPolicy(name)
@@ -176,15 +181,15 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 
 		if c := comment.(string); c == "" {
 			q := builder.RemoveComment()
-			err := snowflake.Exec(db, q)
+			err := snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for network policy %v", name)
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for network policy %v", name))
 			}
 		} else {
 			q := builder.ChangeComment(c)
-			err := snowflake.Exec(db, q)
+			err := snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for network policy %v", name)
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for network policy %v", name))
 			}
 		}
 	}
@@ -192,33 +197,33 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("allowed_ip_list") {
 		newIps := ipChangeParser(d, "allowed_ip_list")
 		q := builder.ChangeIpList("ALLOWED", newIps)
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating ALLOWED_IP_LIST for network policy %v", name)
+			return diag.FromErr(errors.Wrapf(err, "error updating ALLOWED_IP_LIST for network policy %v", name))
 		}
 	}
 
 	if d.HasChange("blocked_ip_list") {
 		newIps := ipChangeParser(d, "blocked_ip_list")
 		q := builder.ChangeIpList("BLOCKED", newIps)
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating BLOCKED_IP_LIST for network policy %v", name)
+			return diag.FromErr(errors.Wrapf(err, "error updating BLOCKED_IP_LIST for network policy %v", name))
 		}
 	}
 
-	return ReadNetworkPolicy(d, meta)
+	return ReadNetworkPolicy(ctx, d, meta)
 }
 
-// DeleteNetworkPolicy implements schema.DeleteFunc
-func DeleteNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+// DeleteNetworkPolicy implements schema.DeleteContextFunc
+func DeleteNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Id()
 
 	dropSql := snowflake.NetworkPolicy(name).Drop()
-	err := snowflake.Exec(db, dropSql)
+	err := snowflake.Exec(ctx, db, dropSql)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting network policy %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error deleting network policy %v", name))
 	}
 
 	d.SetId("")
@@ -1,9 +1,11 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -32,10 +34,10 @@ var networkPolicyAttachmentSchema = map[string]*schema.Schema{
 // NetworkPolicyAttachment returns a pointer to the resource representing a network policy attachment
 func NetworkPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNetworkPolicyAttachment,
-		Read:   ReadNetworkPolicyAttachment,
-		Update: UpdateNetworkPolicyAttachment,
-		Delete: DeleteNetworkPolicyAttachment,
+		CreateContext: CreateNetworkPolicyAttachment,
+		ReadContext:   ReadNetworkPolicyAttachment,
+		UpdateContext: UpdateNetworkPolicyAttachment,
+		DeleteContext: DeleteNetworkPolicyAttachment,
 
 		Schema: networkPolicyAttachmentSchema,
 		Importer: &schema.ResourceImporter{
@@ -44,53 +46,53 @@ func NetworkPolicyAttachment() *schema.Resource {
 	}
 }
 
-// CreateNetworkPolicyAttachment implements schema.CreateFunc
-func CreateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// CreateNetworkPolicyAttachment implements schema.CreateContextFunc
+func CreateNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policyName := d.Get("network_policy_name").(string)
 	d.SetId(policyName + "_attachment")
 
 	if d.Get("set_for_account").(bool) {
-		err := setOnAccount(d, meta)
+		err := setOnAccount(ctx, d, meta)
 		if err != nil {
-			return errors.Wrapf(err, "error creating attachment for network policy %v", policyName)
+			return diag.FromErr(errors.Wrapf(err, "error creating attachment for network policy %v", policyName))
 		}
 	}
 
 	if u, ok := d.GetOk("users"); ok {
 		users := expandStringList(u.(*schema.Set).List())
 
-		err := ensureUserAlterPrivileges(users, meta)
+		err := ensureUserAlterPrivileges(ctx, users, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = setOnUsers(users, d, meta)
+		err = setOnUsers(ctx, users, d, meta)
 		if err != nil {
-			return errors.Wrapf(err, "error creating attachment for network policy %v", policyName)
+			return diag.FromErr(errors.Wrapf(err, "error creating attachment for network policy %v", policyName))
 		}
 	}
 
 	return nil
 }
 
-// ReadNetworkPolicyAttachment implements schema.ReadFunc
-func ReadNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// ReadNetworkPolicyAttachment implements schema.ReadContextFunc
+func ReadNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// HACK: InternalValidate requires Read to be implemented
 	// There is no way of using SHOW/DESC on Network Policies/Users to pull attachment information, so we can't actually Read
 	return nil
 }
 
-// UpdateNetworkPolicyAttachment implements schema.UpdateFunc
-func UpdateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// UpdateNetworkPolicyAttachment implements schema.UpdateContextFunc
+func UpdateNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("set_for_account") {
 		oldAcctFlag, newAcctFlag := d.GetChange("set_for_account")
 		if newAcctFlag.(bool) {
-			if err := setOnAccount(d, meta); err != nil {
-				return err
+			if err := setOnAccount(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		} else if !newAcctFlag.(bool) && oldAcctFlag == true {
-			if err := unsetOnAccount(d, meta); err != nil {
-				return err
+			if err := unsetOnAccount(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -103,27 +105,27 @@ func UpdateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 		removedUsers := expandStringList(oldUsersSet.Difference(newUsersSet).List())
 		addedUsers := expandStringList(newUsersSet.Difference(oldUsersSet).List())
 
-		err := ensureUserAlterPrivileges(removedUsers, meta)
+		err := ensureUserAlterPrivileges(ctx, removedUsers, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = ensureUserAlterPrivileges(addedUsers, meta)
+		err = ensureUserAlterPrivileges(ctx, addedUsers, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, user := range removedUsers {
-			err := unsetOnUser(user, d, meta)
+			err := unsetOnUser(ctx, user, d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		for _, user := range addedUsers {
-			err := setOnUser(user, d, meta)
+			err := setOnUser(ctx, user, d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -131,27 +133,27 @@ func UpdateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-// DeleteNetworkPolicyAttachment implements schema.DeleteFunc
-func DeleteNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+// DeleteNetworkPolicyAttachment implements schema.DeleteContextFunc
+func DeleteNetworkPolicyAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	policyName := d.Get("network_policy_name").(string)
 	d.SetId(policyName + "_attachment")
 
-	err := unsetOnAccount(d, meta)
+	err := unsetOnAccount(ctx, d, meta)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting attachment for network policy %v", policyName)
+		return diag.FromErr(errors.Wrapf(err, "error deleting attachment for network policy %v", policyName))
 	}
 
 	if u, ok := d.GetOk("users"); ok {
 		users := expandStringList(u.(*schema.Set).List())
 
-		err := ensureUserAlterPrivileges(users, meta)
+		err := ensureUserAlterPrivileges(ctx, users, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = unsetOnUsers(users, d, meta)
+		err = unsetOnUsers(ctx, users, d, meta)
 		if err != nil {
-			return errors.Wrapf(err, "error deleting attachment for network policy %v", policyName)
+			return diag.FromErr(errors.Wrapf(err, "error deleting attachment for network policy %v", policyName))
 		}
 	}
 
@@ -160,13 +162,13 @@ func DeleteNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 
 // setOnAccount sets the network policy globally for the Snowflake account
 // Note: the ip address of the session executing this SQL must be allowed by the network policy being set
-func setOnAccount(d *schema.ResourceData, meta interface{}) error {
+func setOnAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := d.Get("network_policy_name").(string)
 
 	acctSql := snowflake.NetworkPolicy(policyName).SetOnAccount()
 
-	err := snowflake.Exec(db, acctSql)
+	err := snowflake.Exec(ctx, db, acctSql)
 	if err != nil {
 		return errors.Wrapf(err, "error setting network policy %v on account", policyName)
 	}
@@ -175,13 +177,13 @@ func setOnAccount(d *schema.ResourceData, meta interface{}) error {
 }
 
 // setOnAccount unsets the network policy globally for the Snowflake account
-func unsetOnAccount(d *schema.ResourceData, meta interface{}) error {
+func unsetOnAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := d.Get("network_policy_name").(string)
 
 	acctSql := snowflake.NetworkPolicy(policyName).UnsetOnAccount()
 
-	err := snowflake.Exec(db, acctSql)
+	err := snowflake.Exec(ctx, db, acctSql)
 	if err != nil {
 		return errors.Wrapf(err, "error unsetting network policy %v on account", policyName)
 	}
@@ -190,10 +192,10 @@ func unsetOnAccount(d *schema.ResourceData, meta interface{}) error {
 }
 
 // setOnUsers sets the network policy for list of users
-func setOnUsers(users []string, data *schema.ResourceData, meta interface{}) error {
+func setOnUsers(ctx context.Context, users []string, data *schema.ResourceData, meta interface{}) error {
 	policyName := data.Get("network_policy_name").(string)
 	for _, user := range users {
-		err := setOnUser(user, data, meta)
+		err := setOnUser(ctx, user, data, meta)
 		if err != nil {
 			return errors.Wrapf(err, "error setting network policy %v on user %v", policyName, user)
 		}
@@ -203,11 +205,11 @@ func setOnUsers(users []string, data *schema.ResourceData, meta interface{}) err
 }
 
 // setOnUser sets the network policy for a given user
-func setOnUser(user string, data *schema.ResourceData, meta interface{}) error {
+func setOnUser(ctx context.Context, user string, data *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := data.Get("network_policy_name").(string)
 	userSql := snowflake.NetworkPolicy(policyName).SetOnUser(user)
-	err := snowflake.Exec(db, userSql)
+	err := snowflake.Exec(ctx, db, userSql)
 	if err != nil {
 		return errors.Wrapf(err, "error setting network policy %v on user %v", policyName, user)
 	}
@@ -216,10 +218,10 @@ func setOnUser(user string, data *schema.ResourceData, meta interface{}) error {
 }
 
 // unsetOnUsers unsets the network policy for list of users
-func unsetOnUsers(users []string, data *schema.ResourceData, meta interface{}) error {
+func unsetOnUsers(ctx context.Context, users []string, data *schema.ResourceData, meta interface{}) error {
 	policyName := data.Get("network_policy_name").(string)
 	for _, user := range users {
-		err := unsetOnUser(user, data, meta)
+		err := unsetOnUser(ctx, user, data, meta)
 		if err != nil {
 			return errors.Wrapf(err, "error unsetting network policy %v on user %v", policyName, user)
 		}
@@ -229,11 +231,11 @@ func unsetOnUsers(users []string, data *schema.ResourceData, meta interface{}) e
 }
 
 // unsetOnUser sets the network policy for a given user
-func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error {
+func unsetOnUser(ctx context.Context, user string, data *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	policyName := data.Get("network_policy_name").(string)
 	userSql := snowflake.NetworkPolicy(policyName).UnsetOnUser(user)
-	err := snowflake.Exec(db, userSql)
+	err := snowflake.Exec(ctx, db, userSql)
 	if err != nil {
 		return errors.Wrapf(err, "error unsetting network policy %v on user %v", policyName, user)
 	}
@@ -242,11 +244,11 @@ func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error
 }
 
 // ensureUserAlterPrivileges ensures the executing Snowflake user can alter each user in the set of users
-func ensureUserAlterPrivileges(users []string, meta interface{}) error {
+func ensureUserAlterPrivileges(ctx context.Context, users []string, meta interface{}) error {
 	db := meta.(*sql.DB)
 	for _, user := range users {
 		userDescSql := snowflake.User(user).Describe()
-		err := snowflake.Exec(db, userDescSql)
+		err := snowflake.Exec(ctx, db, userDescSql)
 		if err != nil {
 			return errors.Wrapf(err, "error altering network policy of user %v", user)
 		}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -34,8 +35,8 @@ func TestNetworkPolicyAttachmentCreate(t *testing.T) {
 		mock.ExpectExec(`^DESCRIBE USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER USER "test-user" SET NETWORK_POLICY = "test-network-policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.CreateNetworkPolicyAttachment(d, db)
-		r.NoError(err)
+		diags := resources.CreateNetworkPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -55,7 +56,7 @@ func TestNetworkPolicyAttachmentDelete(t *testing.T) {
 		mock.ExpectExec(`^DESCRIBE USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER USER "test-user" UNSET NETWORK_POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteNetworkPolicyAttachment(d, db)
-		r.NoError(err)
+		diags := resources.DeleteNetworkPolicyAttachment(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
@@ -1,11 +1,13 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -57,10 +59,10 @@ var notificationIntegrationSchema = map[string]*schema.Schema{
 // NotificationIntegration returns a pointer to the resource representing a notification integration
 func NotificationIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateNotificationIntegration,
-		Read:   ReadNotificationIntegration,
-		Update: UpdateNotificationIntegration,
-		Delete: DeleteNotificationIntegration,
+		CreateContext: CreateNotificationIntegration,
+		ReadContext:   ReadNotificationIntegration,
+		UpdateContext: UpdateNotificationIntegration,
+		DeleteContext: DeleteNotificationIntegration,
 
 		Schema: notificationIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -69,8 +71,8 @@ func NotificationIntegration() *schema.Resource {
 	}
 }
 
-// CreateNotificationIntegration implements schema.CreateFunc
-func CreateNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateNotificationIntegration implements schema.CreateContextFunc
+func CreateNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -88,49 +90,49 @@ func CreateNotificationIntegration(d *schema.ResourceData, meta interface{}) err
 	// Now, set the notification provider
 	err := setNotificationProviderSettings(d, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, stmt.Statement())
+	err = snowflake.Exec(ctx, db, stmt.Statement())
 	if err != nil {
-		return fmt.Errorf("error creating notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating notification integration: %w", err))
 	}
 
 	d.SetId(name)
 
-	return ReadNotificationIntegration(d, meta)
+	return ReadNotificationIntegration(ctx, d, meta)
 }
 
-// ReadNotificationIntegration implements schema.ReadFunc
-func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadNotificationIntegration implements schema.ReadContextFunc
+func ReadNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.NotificationIntegration(d.Id()).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanNotificationIntegration(row)
 	if err != nil {
-		return fmt.Errorf("Could not show notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not show notification integration: %w", err))
 	}
 
 	// Note: category must be STORAGE or something is broken
 	if c := s.Category.String; c != "NOTIFICATION" {
-		return fmt.Errorf("Expected %v to be a NOTIFICATION integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("Expected %v to be a NOTIFICATION integration, got %v", id, c))
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -138,40 +140,43 @@ func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.NotificationIntegration(d.Id()).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("Could not describe notification integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not describe notification integration: %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		switch k {
 		case "ENABLED":
 			// We set this using the SHOW INTEGRATION call so let's ignore it here
 		case "NOTIFICATION_PROVIDER":
 			if err = d.Set("notification_provider", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_STORAGE_QUEUE_PRIMARY_URI":
 			if err = d.Set("azure_storage_queue_primary_uri", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "AZURE_TENANT_ID":
 			if err = d.Set("azure_tenant_id", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		default:
 			log.Printf("[WARN] unexpected property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// UpdateNotificationIntegration implements schema.UpdateFunc
-func UpdateNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateNotificationIntegration implements schema.UpdateContextFunc
+func UpdateNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -195,7 +200,7 @@ func UpdateNotificationIntegration(d *schema.ResourceData, meta interface{}) err
 		runSetStatement = true
 		err := setNotificationProviderSettings(d, stmt)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if d.HasChange("azure_storage_queue_primary_uri") {
@@ -209,35 +214,17 @@ func UpdateNotificationIntegration(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating notification integration: %w", err)
+		if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating notification integration: %w", err))
 		}
 	}
 
-	return ReadNotificationIntegration(d, meta)
+	return ReadNotificationIntegration(ctx, d, meta)
 }
 
-// DeleteNotificationIntegration implements schema.DeleteFunc
-func DeleteNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.NotificationIntegration)(d, meta)
-}
-
-// NotificationIntegrationExists implements schema.ExistsFunc
-func NotificationIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.NotificationIntegration(id).Show()
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-	return false, nil
+// DeleteNotificationIntegration implements schema.DeleteContextFunc
+func DeleteNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.NotificationIntegration)(ctx, d, meta)
 }
 
 func setNotificationProviderSettings(data *schema.ResourceData, stmt snowflake.SettingBuilder) error {
@@ -0,0 +1,303 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+var objectParameterTypes = []string{
+	string(snowflake.DatabaseType),
+	string(snowflake.SchemaType),
+	string(snowflake.TableType),
+	string(snowflake.UserType),
+}
+
+var objectParameterSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Type of the object the parameter is set on: DATABASE, SCHEMA, TABLE or USER.",
+		ValidateFunc: validation.StringInSlice(objectParameterTypes, false),
+	},
+	"object_identifier": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Identifier of the object the parameter is set on.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Database of the schema or table. Required for schemas and tables only.",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Schema of the table. Required for tables only.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the object.",
+				},
+			},
+		},
+	},
+	"key": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Name of the parameter, e.g. MAX_DATA_EXTENSION_TIME_IN_DAYS. Parameters the resource of the object manages, such as DATA_RETENTION_TIME_IN_DAYS with data_retention_days, cannot be set.",
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"value": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Value of the parameter. Booleans and numbers are given as strings, e.g. \"true\" or \"30\".",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			def, err := objectParameterDefinition(d.Get("object_type").(string), d.Get("key").(string))
+			return err == nil && def.Equal(old, new)
+		},
+	},
+}
+
+// ObjectParameter returns a pointer to the resource representing a parameter
+// set on a database, schema, table or user
+func ObjectParameter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateObjectParameter,
+		ReadContext:   ReadObjectParameter,
+		UpdateContext: UpdateObjectParameter,
+		DeleteContext: DeleteObjectParameter,
+		CustomizeDiff: customizeObjectParameterDiff,
+
+		Schema: objectParameterSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+type objectParameterID struct {
+	ObjectType   string
+	DatabaseName string
+	SchemaName   string
+	ObjectName   string
+	Key          string
+}
+
+// String() takes in a objectParameterID object and returns a pipe-delimited string:
+// objectType|databaseName|schemaName|objectName|key
+func (id *objectParameterID) String() string {
+	return strings.Join([]string{id.ObjectType, id.DatabaseName, id.SchemaName, id.ObjectName, id.Key}, "|")
+}
+
+func objectParameterIDFromString(stringID string) (*objectParameterID, error) {
+	parts := strings.Split(stringID, "|")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("5 fields allowed in object parameter id, got %v: %v", len(parts), stringID)
+	}
+	return &objectParameterID{
+		ObjectType:   parts[0],
+		DatabaseName: parts[1],
+		SchemaName:   parts[2],
+		ObjectName:   parts[3],
+		Key:          parts[4],
+	}, nil
+}
+
+func (id *objectParameterID) builder() *snowflake.ObjectParameterBuilder {
+	name := snowflake.QualifiedObjectName(id.DatabaseName, id.SchemaName, id.ObjectName)
+	return snowflake.ObjectParameter(snowflake.EntityType(id.ObjectType), name, id.Key)
+}
+
+func objectParameterDefinition(objectType, key string) (snowflake.ParameterDefinition, error) {
+	def, ok := snowflake.ObjectParameters[snowflake.EntityType(objectType)][strings.ToUpper(key)]
+	if !ok {
+		return def, fmt.Errorf("%v is not a parameter of %v objects, expected one of %v", key, objectType,
+			strings.Join(parameterKeys(snowflake.ObjectParameters[snowflake.EntityType(objectType)]), ", "))
+	}
+	return def, nil
+}
+
+// customizeObjectParameterDiff validates the identifier and the key against
+// the object type and the value against the type of the parameter so that
+// mistakes fail at plan time
+func customizeObjectParameterDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	objectType := d.Get("object_type").(string)
+	if identifier, ok := d.Get("object_identifier.0").(map[string]interface{}); ok {
+		if err := validateObjectIdentifier(objectType, identifier); err != nil {
+			return err
+		}
+	}
+
+	def, err := objectParameterDefinition(d.Get("object_type").(string), d.Get("key").(string))
+	if err != nil {
+		return err
+	}
+	if err := def.Validate(d.Get("value").(string)); err != nil {
+		return errors.Wrapf(err, "invalid value for parameter %v", d.Get("key"))
+	}
+	return nil
+}
+
+// validateObjectIdentifier checks that identifier has the database of a schema
+// and the database and schema of a table, and nothing more, so that the
+// statements don't fall back to the current database or schema
+func validateObjectIdentifier(objectType string, identifier map[string]interface{}) error {
+	database, schema := identifier["database"].(string), identifier["schema"].(string)
+	switch snowflake.EntityType(objectType) {
+	case snowflake.TableType:
+		if database == "" || schema == "" {
+			return fmt.Errorf("object_identifier must set database and schema for %v objects", objectType)
+		}
+	case snowflake.SchemaType:
+		if database == "" || schema != "" {
+			return fmt.Errorf("object_identifier must set database, but not schema, for %v objects", objectType)
+		}
+	default:
+		if database != "" || schema != "" {
+			return fmt.Errorf("object_identifier cannot set database or schema for %v objects", objectType)
+		}
+	}
+	return nil
+}
+
+// CreateObjectParameter implements schema.CreateContextFunc
+func CreateObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	identifier := d.Get("object_identifier.0").(map[string]interface{})
+	if err := validateObjectIdentifier(d.Get("object_type").(string), identifier); err != nil {
+		return diag.FromErr(err)
+	}
+	id := &objectParameterID{
+		ObjectType:   d.Get("object_type").(string),
+		DatabaseName: identifier["database"].(string),
+		SchemaName:   identifier["schema"].(string),
+		ObjectName:   identifier["name"].(string),
+		Key:          strings.ToUpper(d.Get("key").(string)),
+	}
+
+	if err := setObjectParameter(ctx, d, meta.(*sql.DB), id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return ReadObjectParameter(ctx, d, meta)
+}
+
+// ReadObjectParameter implements schema.ReadContextFunc. A parameter the object
+// inherits from its container or the account is not set on the object, so the
+// resource is removed from the state rather than showing the inherited value.
+func ReadObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := objectParameterIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rows, err := snowflake.Query(ctx, db, id.builder().Show())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading parameter %v", id.Key))
+	}
+	defer rows.Close()
+
+	params, err := snowflake.ScanParameters(rows)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading parameter %v", id.Key))
+	}
+
+	for _, p := range params {
+		// LIKE treats _ as a wildcard, so other keys can match too
+		if p.Key != id.Key {
+			continue
+		}
+		if !p.IsSetOn(snowflake.EntityType(id.ObjectType)) {
+			log.Printf("[DEBUG] parameter (%s) is inherited from %s", d.Id(), p.Level)
+			break
+		}
+
+		identifier := map[string]interface{}{
+			"database": id.DatabaseName,
+			"schema":   id.SchemaName,
+			"name":     id.ObjectName,
+		}
+		if err := d.Set("object_type", id.ObjectType); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("object_identifier", []interface{}{identifier}); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("key", p.Key); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("value", p.Value); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] parameter (%s) not found", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// UpdateObjectParameter implements schema.UpdateContextFunc
+func UpdateObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id, err := objectParameterIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("value") {
+		if err := setObjectParameter(ctx, d, meta.(*sql.DB), id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadObjectParameter(ctx, d, meta)
+}
+
+// DeleteObjectParameter implements schema.DeleteContextFunc. The object
+// inherits the parameter again afterwards.
+func DeleteObjectParameter(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	id, err := objectParameterIDFromString(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := snowflake.Exec(ctx, db, id.builder().Unset()); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error unsetting parameter %v", id.Key))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setObjectParameter(ctx context.Context, d *schema.ResourceData, db *sql.DB, id *objectParameterID) error {
+	def, err := objectParameterDefinition(id.ObjectType, id.Key)
+	if err != nil {
+		return err
+	}
+	value := d.Get("value").(string)
+	if err := def.Validate(value); err != nil {
+		return errors.Wrapf(err, "invalid value for parameter %v", id.Key)
+	}
+
+	err = snowflake.Exec(ctx, db, id.builder().Set(def.Literal(value)))
+	return errors.Wrapf(err, "error setting parameter %v", id.Key)
+}
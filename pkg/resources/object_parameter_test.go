@@ -0,0 +1,145 @@
+package resources_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectParameter(t *testing.T) {
+	r := require.New(t)
+	err := resources.ObjectParameter().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestObjectParameterCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type": "TABLE",
+		"object_identifier": []interface{}{map[string]interface{}{
+			"database": "db",
+			"schema":   "schema",
+			"name":     "table",
+		}},
+		"key":   "max_data_extension_time_in_days",
+		"value": "30",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectParameter().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "db"."schema"."table" SET MAX_DATA_EXTENSION_TIME_IN_DAYS = 30$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadObjectParameter(mock, `TABLE "db"."schema"."table"`, "MAX_DATA_EXTENSION_TIME_IN_DAYS", "30", "TABLE")
+
+		diags := resources.CreateObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+	r.Equal("TABLE|db|schema|table|MAX_DATA_EXTENSION_TIME_IN_DAYS", d.Id())
+	r.Equal("30", d.Get("value"))
+}
+
+func TestObjectParameterCreateWrongKey(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type":       "DATABASE",
+		"object_identifier": []interface{}{map[string]interface{}{"name": "db"}},
+		"key":               "TIMEZONE",
+		"value":             "UTC",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ObjectParameter().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		diags := resources.CreateObjectParameter(context.Background(), d, db)
+		r.NotEmpty(diags)
+	})
+}
+
+func TestObjectParameterIdentifier(t *testing.T) {
+	r := require.New(t)
+
+	validate := func(objectType string, identifier map[string]interface{}, key string) error {
+		_, err := resources.ObjectParameter().Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"object_type":       objectType,
+			"object_identifier": []interface{}{identifier},
+			"key":               key,
+			"value":             "30",
+		}), nil)
+		return err
+	}
+
+	r.NoError(validate("TABLE", map[string]interface{}{"database": "db", "schema": "schema", "name": "table"}, "MAX_DATA_EXTENSION_TIME_IN_DAYS"))
+	r.EqualError(validate("TABLE", map[string]interface{}{"database": "db", "name": "table"}, "MAX_DATA_EXTENSION_TIME_IN_DAYS"),
+		"object_identifier must set database and schema for TABLE objects")
+	r.EqualError(validate("SCHEMA", map[string]interface{}{"name": "schema"}, "MAX_DATA_EXTENSION_TIME_IN_DAYS"),
+		"object_identifier must set database, but not schema, for SCHEMA objects")
+	r.EqualError(validate("DATABASE", map[string]interface{}{"database": "db", "name": "db"}, "MAX_DATA_EXTENSION_TIME_IN_DAYS"),
+		"object_identifier cannot set database or schema for DATABASE objects")
+
+	// the table resource manages the retention with data_retention_days
+	err := validate("TABLE", map[string]interface{}{"database": "db", "schema": "schema", "name": "table"}, "DATA_RETENTION_TIME_IN_DAYS")
+	r.Error(err)
+	r.Contains(err.Error(), "DATA_RETENTION_TIME_IN_DAYS is not a parameter of TABLE objects")
+}
+
+func TestObjectParameterRead(t *testing.T) {
+	r := require.New(t)
+
+	d := objectParameter(t, "USER|||jdoe|TIMEZONE", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadObjectParameter(mock, `USER "jdoe"`, "TIMEZONE", "UTC", "USER")
+
+		diags := resources.ReadObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+	r.Equal("USER", d.Get("object_type"))
+	r.Equal("jdoe", d.Get("object_identifier.0.name"))
+	r.Equal("TIMEZONE", d.Get("key"))
+	r.Equal("UTC", d.Get("value"))
+}
+
+func TestObjectParameterReadInherited(t *testing.T) {
+	r := require.New(t)
+
+	d := objectParameter(t, "DATABASE|||db|MAX_DATA_EXTENSION_TIME_IN_DAYS", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the database uses the account's value, so nothing is set on it
+		expectReadObjectParameter(mock, `DATABASE "db"`, "MAX_DATA_EXTENSION_TIME_IN_DAYS", "14", "ACCOUNT")
+
+		diags := resources.ReadObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+	r.Equal("", d.Id())
+}
+
+func TestObjectParameterDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := objectParameter(t, "USER|||jdoe|TIMEZONE", map[string]interface{}{})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "jdoe" UNSET TIMEZONE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := resources.DeleteObjectParameter(context.Background(), d, db)
+		r.Empty(diags)
+	})
+	r.Equal("", d.Id())
+}
+
+func expectReadObjectParameter(mock sqlmock.Sqlmock, in, key, value, level string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow(key, value, "", level, "", "")
+	mock.ExpectQuery(`^SHOW PARAMETERS LIKE '` + key + `' IN ` + in + `$`).WillReturnRows(rows)
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -74,10 +76,10 @@ var pipeSchema = map[string]*schema.Schema{
 
 func Pipe() *schema.Resource {
 	return &schema.Resource{
-		Create: CreatePipe,
-		Read:   ReadPipe,
-		Update: UpdatePipe,
-		Delete: DeletePipe,
+		CreateContext: CreatePipe,
+		ReadContext:   ReadPipe,
+		UpdateContext: UpdatePipe,
+		DeleteContext: DeletePipe,
 
 		Schema: pipeSchema,
 		Importer: &schema.ResourceImporter{
@@ -141,8 +143,8 @@ func pipeIDFromString(stringID string) (*pipeID, error) {
 	return pipeResult, nil
 }
 
-// CreatePipe implements schema.CreateFunc
-func CreatePipe(d *schema.ResourceData, meta interface{}) error {
+// CreatePipe implements schema.CreateContextFunc
+func CreatePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -169,9 +171,9 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating pipe %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating pipe %v", name))
 	}
 
 	pipeID := &pipeID{
@@ -181,19 +183,19 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := pipeID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadPipe(d, meta)
+	return ReadPipe(ctx, d, meta)
 }
 
-// ReadPipe implements schema.ReadFunc
-func ReadPipe(d *schema.ResourceData, meta interface{}) error {
+// ReadPipe implements schema.ReadContextFunc
+func ReadPipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := pipeID.DatabaseName
@@ -201,7 +203,7 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 	name := pipeID.PipeName
 
 	sq := snowflake.Pipe(name, dbName, schema).Show()
-	row := snowflake.QueryRow(db, sq)
+	row := snowflake.QueryRow(ctx, db, sq)
 	pipe, err := snowflake.ScanPipe(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -210,62 +212,65 @@ func ReadPipe(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", pipe.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", pipe.DatabaseName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", pipe.SchemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("copy_statement", pipe.Definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("owner", pipe.Owner)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", pipe.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("notification_channel", pipe.NotificationChannel)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("auto_ingest", pipe.NotificationChannel != "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if strings.Contains(pipe.NotificationChannel, "arn:aws:sns:") {
 		err = d.Set("aws_sns_topic_arn", pipe.NotificationChannel)
-		return err
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
 	}
 
 	return nil
 }
 
-// UpdatePipe implements schema.UpdateFunc
-func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
+// UpdatePipe implements schema.UpdateContextFunc
+func UpdatePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := pipeID.DatabaseName
@@ -278,21 +283,21 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating pipe comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating pipe comment on %v", d.Id()))
 		}
 	}
 
-	return ReadPipe(d, meta)
+	return ReadPipe(ctx, d, meta)
 }
 
-// DeletePipe implements schema.DeleteFunc
-func DeletePipe(d *schema.ResourceData, meta interface{}) error {
+// DeletePipe implements schema.DeleteContextFunc
+func DeletePipe(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := pipeID.DatabaseName
@@ -301,38 +306,12 @@ func DeletePipe(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Pipe(pipe, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting pipe %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting pipe %v", d.Id()))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-// PipeExists implements schema.ExistsFunc
-func PipeExists(data *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	pipeID, err := pipeIDFromString(data.Id())
-	if err != nil {
-		return false, err
-	}
-
-	dbName := pipeID.DatabaseName
-	schema := pipeID.SchemaName
-	pipe := pipeID.PipeName
-
-	q := snowflake.Pipe(pipe, dbName, schema).Show()
-	rows, err := db.Query(q)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-
-	return false, nil
-}
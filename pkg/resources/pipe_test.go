@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -37,8 +38,8 @@ func TestPipeCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadPipe(mock)
-		err := resources.CreatePipe(d, db)
-		r.NoError(err)
+		diags := resources.CreatePipe(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -58,9 +59,9 @@ func TestPipeRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Pipe("test_pipe", "test_db", "test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadPipe(d, db)
+		diags := resources.ReadPipe(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
 
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -101,9 +103,9 @@ var procedureGrantSchema = map[string]*schema.Schema{
 func ProcedureGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateProcedureGrant,
-			Read:   ReadProcedureGrant,
-			Delete: DeleteProcedureGrant,
+			CreateContext: CreateProcedureGrant,
+			ReadContext:   ReadProcedureGrant,
+			DeleteContext: DeleteProcedureGrant,
 
 			Schema: procedureGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -114,8 +116,8 @@ func ProcedureGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateProcedureGrant implements schema.CreateFunc
-func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateProcedureGrant implements schema.CreateContextFunc
+func CreateProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		procedureName      string
 		arguments          []interface{}
@@ -128,12 +130,12 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		if args, ok := d.GetOk("arguments"); ok {
 			arguments = args.([]interface{})
 		} else {
-			return errors.New("arguments must be set when specifying procedure_name.")
+			return diag.FromErr(errors.New("arguments must be set when specifying procedure_name."))
 		}
 		if ret, ok := d.GetOk("return_type"); ok {
 			returnType = strings.ToUpper(ret.(string))
 		} else {
-			return errors.New("return_type must be set when specifying procedure_name.")
+			return diag.FromErr(errors.New("return_type must be set when specifying procedure_name."))
 		}
 	}
 	dbName := d.Get("database_name").(string)
@@ -143,10 +145,10 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (procedureName == "") && !futureProcedures {
-		return errors.New("procedure_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("procedure_name must be set unless on_future is true."))
 	}
 	if (procedureName != "") && futureProcedures {
-		return errors.New("procedure_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("procedure_name must be empty if on_future is true."))
 	}
 
 	if procedureName != "" {
@@ -162,9 +164,9 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ProcedureGrant(dbName, schemaName, procedureName, argumentTypes)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -176,15 +178,15 @@ func CreateProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadProcedureGrant(d, meta)
+	return ReadProcedureGrant(ctx, d, meta)
 }
 
-// ReadProcedureGrant implements schema.ReadFunc
-func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadProcedureGrant implements schema.ReadContextFunc
+func ReadProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		procedureName string
 		returnType    string
@@ -193,7 +195,7 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	)
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -202,11 +204,11 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureProceduresEnabled := false
 	if procedureSignature == "" {
@@ -214,7 +216,7 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		procedureSignatureMap, err := parseCallableObjectName(procedureSignature)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		procedureName = procedureSignatureMap["callableName"].(string)
 		returnType = procedureSignatureMap["returnType"].(string)
@@ -223,27 +225,27 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("procedure_name", procedureName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("arguments", arguments)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("return_type", returnType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureProceduresEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -253,14 +255,17 @@ func ReadProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ProcedureGrant(dbName, schemaName, procedureName, argumentTypes)
 	}
 
-	return readGenericGrant(d, meta, procedureGrantSchema, builder, futureProceduresEnabled, validProcedurePrivileges)
+	if err := readGenericGrant(ctx, d, meta, procedureGrantSchema, builder, futureProceduresEnabled, validProcedurePrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteProcedureGrant implements schema.DeleteFunc
-func DeleteProcedureGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteProcedureGrant implements schema.DeleteContextFunc
+func DeleteProcedureGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -273,11 +278,14 @@ func DeleteProcedureGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		procedureSignatureMap, err := parseCallableObjectName(grantID.ObjectName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		procedureName := procedureSignatureMap["callableName"].(string)
 		argumentTypes := procedureSignatureMap["argumentTypes"].([]string)
 		builder = snowflake.ProcedureGrant(dbName, schemaName, procedureName, argumentTypes)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
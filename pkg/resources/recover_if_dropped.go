@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const recoverIfDroppedKey = "recover_if_dropped"
+
+var recoverIfDroppedSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Restores the object most recently dropped under the same name with UNDROP instead of creating a new one, e.g. to get back an object destroyed by mistake within its data retention period. The restored object is then altered to match the configuration. The object is created as usual when there is nothing to restore. Creation fails instead when the restored object differs in an attribute that cannot be altered, such as whether it is transient, so that the configuration can be fixed before it is replaced.",
+}
+
+// undrop runs the UNDROP statement q when the recover_if_dropped attribute of
+// d is on. It returns false when the object should be created instead, i.e.
+// when the attribute is off or there was nothing to restore.
+func undrop(ctx context.Context, d *schema.ResourceData, db *sql.DB, q string) bool {
+	if !d.Get(recoverIfDroppedKey).(bool) {
+		return false
+	}
+	err := snowflake.Exec(ctx, db, q)
+	if err != nil {
+		log.Printf("[DEBUG] unable to restore %v, creating it instead: %v", d.Get("name"), err)
+		return false
+	}
+	return true
+}
+
+// checkRecovered returns an error when one of the given attributes, which
+// cannot be altered, differs between the restored object read into actual and
+// the configuration of d. The object would otherwise be replaced by the next
+// plan, dropping what was just restored, so it is left out of the state for
+// the configuration to be fixed first.
+func checkRecovered(actual, d *schema.ResourceData, keys ...string) error {
+	for _, key := range keys {
+		o, n := actual.Get(key), d.Get(key)
+		if s, ok := o.(string); ok && strings.EqualFold(s, n.(string)) {
+			continue
+		}
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		return fmt.Errorf("the restored %v has %v = %v but the configuration sets %v, which would replace it; update the configuration to match", d.Get("name"), key, o, n)
+	}
+	return nil
+}
+
+// recoveredChanges compares the attributes of a restored object, or of one
+// copied from another, read into actual, with the configured ones of d, so
+// that an update can reconcile them.
+// Computed attributes missing from the configuration keep their restored
+// values.
+type recoveredChanges struct {
+	schema map[string]*schema.Schema
+	actual *schema.ResourceData
+	d      *schema.ResourceData
+}
+
+func (c recoveredChanges) GetChange(key string) (interface{}, interface{}) {
+	o := c.actual.Get(key)
+	if _, ok := c.d.GetOkExists(key); !ok && c.schema[key].Computed { // nolint: staticcheck
+		return o, o
+	}
+	return o, c.d.Get(key)
+}
+
+func (c recoveredChanges) HasChange(key string) bool {
+	o, n := c.GetChange(key)
+	return !reflect.DeepEqual(o, n)
+}
+
+// attributeChanges tells the current and the desired values of the attributes
+// of an object. *schema.ResourceData implements it for updates.
+type attributeChanges interface {
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
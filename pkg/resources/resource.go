@@ -1,9 +1,11 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -13,9 +15,9 @@ func CreateResource(
 	properties []string,
 	s map[string]*schema.Schema,
 	builder func(string) *snowflake.Builder,
-	read func(*schema.ResourceData, interface{}) error,
-) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+	read schema.ReadContextFunc,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		db := meta.(*sql.DB)
 		name := d.Get("name").(string)
 
@@ -37,15 +39,15 @@ func CreateResource(
 				}
 			}
 		}
-		err := snowflake.Exec(db, qb.Statement())
+		err := snowflake.Exec(ctx, db, qb.Statement())
 
 		if err != nil {
-			return errors.Wrapf(err, "error creating %s", t)
+			return diag.FromErr(errors.Wrapf(err, "error creating %s", t))
 		}
 
 		d.SetId(name)
 
-		return read(d, meta)
+		return read(ctx, d, meta)
 	}
 }
 
@@ -54,9 +56,9 @@ func UpdateResource(
 	properties []string,
 	s map[string]*schema.Schema,
 	builder func(string) *snowflake.Builder,
-	read func(*schema.ResourceData, interface{}) error,
-) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+	read schema.ReadContextFunc,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		db := meta.(*sql.DB)
 		if d.HasChange("name") {
 			// I wish this could be done on one line.
@@ -66,9 +68,9 @@ func UpdateResource(
 
 			stmt := builder(oldName).Rename(newName)
 
-			err := snowflake.Exec(db, stmt)
+			err := snowflake.Exec(ctx, db, stmt)
 			if err != nil {
-				return errors.Wrapf(err, "error renaming %s %s to %s", t, oldName, newName)
+				return diag.FromErr(errors.Wrapf(err, "error renaming %s %s to %s", t, oldName, newName))
 			}
 			d.SetId(newName)
 		}
@@ -98,25 +100,29 @@ func UpdateResource(
 				}
 			}
 
-			err := snowflake.Exec(db, qb.Statement())
+			err := snowflake.Exec(ctx, db, qb.Statement())
 			if err != nil {
-				return errors.Wrapf(err, "error altering %s", t)
+				return diag.FromErr(errors.Wrapf(err, "error altering %s", t))
 			}
 		}
-		return read(d, meta)
+		return read(ctx, d, meta)
 	}
 }
 
-func DeleteResource(t string, builder func(string) *snowflake.Builder) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func DeleteResource(t string, builder func(string) *snowflake.Builder) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		db := meta.(*sql.DB)
 		name := d.Get("name").(string)
 
+		if err := checkDeletionProtection(d, t); err != nil {
+			return diag.FromErr(err)
+		}
+
 		stmt := builder(name).Drop()
 
-		err := snowflake.Exec(db, stmt)
+		err := snowflake.Exec(ctx, db, stmt)
 		if err != nil {
-			return errors.Wrapf(err, "error dropping %s %s", t, name)
+			return diag.FromErr(errors.Wrapf(err, "error dropping %s %s", t, name))
 		}
 
 		d.SetId("")
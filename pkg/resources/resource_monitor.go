@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -75,10 +77,10 @@ var resourceMonitorSchema = map[string]*schema.Schema{
 // ResourceMonitor returns a pointer to the resource representing a resource monitor
 func ResourceMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateResourceMonitor,
-		Read:   ReadResourceMonitor,
+		CreateContext: CreateResourceMonitor,
+		ReadContext:   ReadResourceMonitor,
 		// Update: UpdateResourceMonitor, @TODO implement updates
-		Delete: DeleteResourceMonitor,
+		DeleteContext: DeleteResourceMonitor,
 
 		Schema: resourceMonitorSchema,
 		Importer: &schema.ResourceImporter{
@@ -88,7 +90,7 @@ func ResourceMonitor() *schema.Resource {
 }
 
 // CreateResourceMonitor implents schema.CreateFunc
-func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
+func CreateResourceMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -122,22 +124,22 @@ func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 
 	stmt := cb.Statement()
 
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating resource monitor %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating resource monitor %v", name))
 	}
 
 	d.SetId(name)
 
-	return ReadResourceMonitor(d, meta)
+	return ReadResourceMonitor(ctx, d, meta)
 }
 
-// ReadResourceMonitor implements schema.ReadFunc
-func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
+// ReadResourceMonitor implements schema.ReadContextFunc
+func ReadResourceMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	stmt := snowflake.ResourceMonitor(d.Id()).Show()
 
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	rm, err := snowflake.ScanResourceMonitor(row)
 	if err == sql.ErrNoRows {
@@ -147,7 +149,7 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Set string values
@@ -159,46 +161,49 @@ func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = setDataFromNullStrings(d, nullStrings)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Snowflake returns credit_quota as a float, but only accepts input as an int
 	if rm.CreditQuota.Valid {
 		cqf, err := strconv.ParseFloat(rm.CreditQuota.String, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = d.Set("credit_quota", int(cqf))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// Triggers
 	sTrigs, err := extractTriggerInts(rm.SuspendAt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("suspend_triggers", sTrigs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	siTrigs, err := extractTriggerInts(rm.SuspendImmediatelyAt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("suspend_immediate_triggers", siTrigs)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	nTrigs, err := extractTriggerInts(rm.NotifyAt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("notify_triggers", nTrigs)
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 // setDataFromNullString blanks the value if v is null, otherwise sets the value to the value of v
@@ -236,36 +241,17 @@ func extractTriggerInts(s sql.NullString) ([]int, error) {
 	return out, nil
 }
 
-// DeleteResourceMonitor implements schema.DeleteFunc
-func DeleteResourceMonitor(d *schema.ResourceData, meta interface{}) error {
+// DeleteResourceMonitor implements schema.DeleteContextFunc
+func DeleteResourceMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 
 	stmt := snowflake.ResourceMonitor(d.Id()).Drop()
 
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting resource monitor %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting resource monitor %v", d.Id()))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-// ResourceMonitorExists implements schema.ExistsFunc
-func ResourceMonitorExists(data *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-
-	q := snowflake.ResourceMonitor(data.Id()).Show()
-
-	rows, err := db.Query(q)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-
-	return false, nil
-}
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,9 +49,9 @@ var resourceMonitorGrantSchema = map[string]*schema.Schema{
 func ResourceMonitorGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateResourceMonitorGrant,
-			Read:   ReadResourceMonitorGrant,
-			Delete: DeleteResourceMonitorGrant,
+			CreateContext: CreateResourceMonitorGrant,
+			ReadContext:   ReadResourceMonitorGrant,
+			DeleteContext: DeleteResourceMonitorGrant,
 
 			Schema: resourceMonitorGrantSchema,
 		},
@@ -56,16 +59,16 @@ func ResourceMonitorGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateResourceMonitorGrant implements schema.CreateFunc
-func CreateResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateResourceMonitorGrant implements schema.CreateContextFunc
+func CreateResourceMonitorGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	w := d.Get("monitor_name").(string)
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	builder := snowflake.ResourceMonitorGrant(w)
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -75,48 +78,54 @@ func CreateResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadResourceMonitorGrant(d, meta)
+	return ReadResourceMonitorGrant(ctx, d, meta)
 }
 
-// ReadResourceMonitorGrant implements schema.ReadFunc
-func ReadResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadResourceMonitorGrant implements schema.ReadContextFunc
+func ReadResourceMonitorGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 	priv := grantID.Privilege
 
 	err = d.Set("monitor_name", w)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.ResourceMonitorGrant(w)
-	return readGenericGrant(d, meta, resourceMonitorGrantSchema, builder, false, validResourceMonitorPrivileges)
+	if err := readGenericGrant(ctx, d, meta, resourceMonitorGrantSchema, builder, false, validResourceMonitorPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteResourceMonitorGrant implements schema.DeleteFunc
-func DeleteResourceMonitorGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteResourceMonitorGrant implements schema.DeleteContextFunc
+func DeleteResourceMonitorGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 
 	builder := snowflake.ResourceMonitorGrant(w)
 
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,8 +38,8 @@ func TestResourceMonitorGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT MONITOR ON RESOURCE MONITOR "test-monitor" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT MONITOR ON RESOURCE MONITOR "test-monitor" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadResourceMonitorGrant(mock)
-		err := resources.CreateResourceMonitorGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateResourceMonitorGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -56,8 +57,8 @@ func TestResourceMonitorGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadResourceMonitorGrant(mock)
-		err := resources.ReadResourceMonitorGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadResourceMonitorGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -40,8 +41,8 @@ func TestResourceMonitorCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadResourceMonitor(mock)
-		err := resources.CreateResourceMonitor(d, db)
-		r.NoError(err)
+		diags := resources.CreateResourceMonitor(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -69,27 +70,8 @@ func TestResourceMonitorDelete(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP RESOURCE MONITOR "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteResourceMonitor(d, db)
-		r.NoError(err)
-	})
-}
-
-func TestResourceMonitorExists(t *testing.T) {
-	r := require.New(t)
-
-	in := map[string]interface{}{
-		"name": "good_name",
-	}
-
-	d := schema.TestResourceDataRaw(t, resources.ResourceMonitor().Schema, in)
-	d.SetId("good_name")
-
-	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		expectReadResourceMonitor(mock)
-
-		ok, err := resources.ResourceMonitorExists(d, db)
-		r.NoError(err)
-		r.True(ok)
+		diags := resources.DeleteResourceMonitor(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -107,8 +89,8 @@ func TestResourceMonitorRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.ResourceMonitor(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadResourceMonitor(d, db)
+		diags := resources.ReadResourceMonitor(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
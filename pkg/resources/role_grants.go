@@ -1,21 +1,21 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jmoiron/sqlx"
 )
 
 func RoleGrants() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateRoleGrants,
-		Read:   ReadRoleGrants,
-		Delete: DeleteRoleGrants,
-		Update: UpdateRoleGrants,
+		CreateContext: CreateRoleGrants,
+		ReadContext:   ReadRoleGrants,
+		DeleteContext: DeleteRoleGrants,
+		UpdateContext: UpdateRoleGrants,
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -47,63 +47,55 @@ func RoleGrants() *schema.Resource {
 	}
 }
 
-func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func CreateRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	if len(roles) == 0 && len(users) == 0 {
-		return fmt.Errorf("no users or roles specified for role grants")
+		return diag.FromErr(fmt.Errorf("no users or roles specified for role grants"))
 	}
 
 	for _, role := range roles {
-		err := grantRoleToRole(db, roleName, role)
+		err := grantRoleToRole(ctx, db, roleName, role)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	for _, user := range users {
-		err := grantRoleToUser(db, roleName, user)
+		err := grantRoleToUser(ctx, db, roleName, user)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(roleName)
-	return ReadRoleGrants(d, meta)
+	return ReadRoleGrants(ctx, d, meta)
 }
 
-func grantRoleToRole(db *sql.DB, role1, role2 string) error {
+func grantRoleToRole(ctx context.Context, db *sql.DB, role1, role2 string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.Role(role2).Grant())
+	err := snowflake.Exec(ctx, db, g.Role(role2).Grant())
 	return err
 }
 
-func grantRoleToUser(db *sql.DB, role1, user string) error {
+func grantRoleToUser(ctx context.Context, db *sql.DB, role1, user string) error {
 	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.User(user).Grant())
+	err := snowflake.Exec(ctx, db, g.User(user).Grant())
 	return err
 }
 
-type roleGrant struct {
-	CreatedOn   sql.RawBytes   `db:"created_on"`
-	Role        sql.NullString `db:"role"`
-	GrantedTo   sql.NullString `db:"granted_to"`
-	GranteeName sql.NullString `db:"grantee_name"`
-	Grantedby   sql.NullString `db:"granted_by"`
-}
-
-func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func ReadRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Id()
 
 	roles := make([]string, 0)
 	users := make([]string, 0)
 
-	grants, err := readGrants(db, roleName)
+	grants, err := snowflake.ListRoleGrantees(ctx, db, roleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, grant := range grants {
@@ -113,60 +105,27 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		case "USER":
 			users = append(users, grant.GranteeName.String)
 		default:
-			return fmt.Errorf("unknown grant type %s", grant.GrantedTo.String)
+			return diag.FromErr(fmt.Errorf("unknown grant type %s", grant.GrantedTo.String))
 		}
 	}
 
 	err = d.Set("role_name", roleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("roles", roles)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("users", users)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func readGrants(db *sql.DB, roleName string) ([]*roleGrant, error) {
-	sdb := sqlx.NewDb(db, "snowflake")
-
-	stmt := fmt.Sprintf(`SHOW GRANTS OF ROLE "%s"`, roleName)
-	rows, err := sdb.Queryx(stmt)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	grants := make([]*roleGrant, 0)
-	for rows.Next() {
-		g := &roleGrant{}
-		err = rows.StructScan(g)
-		if err != nil {
-			return nil, err
-		}
-		grants = append(grants, g)
-
-	}
-
-	for _, g := range grants {
-		if g.GranteeName.Valid {
-			s := g.GranteeName.String
-			s = strings.TrimPrefix(s, `"`)
-			s = strings.TrimSuffix(s, `"`)
-			g.GranteeName = sql.NullString{String: s}
-		}
-	}
-
-	return grants, nil
-}
-
-func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func DeleteRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 
@@ -174,16 +133,16 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	for _, role := range roles {
-		err := revokeRoleFromRole(db, roleName, role)
+		err := revokeRoleFromRole(ctx, db, roleName, role)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	for _, user := range users {
-		err := revokeRoleFromUser(db, roleName, user)
+		err := revokeRoleFromUser(ctx, db, roleName, user)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -191,23 +150,23 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
+func revokeRoleFromRole(ctx context.Context, db *sql.DB, role1, role2 string) error {
 	rg := snowflake.RoleGrant(role1).Role(role2)
-	err := snowflake.Exec(db, rg.Revoke())
+	err := snowflake.Exec(ctx, db, rg.Revoke())
 	return err
 }
 
-func revokeRoleFromUser(db *sql.DB, role1, user string) error {
+func revokeRoleFromUser(ctx context.Context, db *sql.DB, role1, user string) error {
 	rg := snowflake.RoleGrant(role1).User(user)
-	err := snowflake.Exec(db, rg.Revoke())
+	err := snowflake.Exec(ctx, db, rg.Revoke())
 	return err
 }
 
-func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
+func UpdateRoleGrants(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	roleName := d.Get("role_name").(string)
 
-	x := func(resource string, grant func(db *sql.DB, role string, target string) error, revoke func(db *sql.DB, role string, target string) error) error {
+	x := func(resource string, grant func(ctx context.Context, db *sql.DB, role string, target string) error, revoke func(ctx context.Context, db *sql.DB, role string, target string) error) error {
 		o, n := d.GetChange(resource)
 
 		if o == nil {
@@ -223,13 +182,13 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		add := expandStringList(ns.Difference(os).List())
 
 		for _, user := range remove {
-			err := revoke(db, roleName, user)
+			err := revoke(ctx, db, roleName, user)
 			if err != nil {
 				return err
 			}
 		}
 		for _, user := range add {
-			err := grant(db, roleName, user)
+			err := grant(ctx, db, roleName, user)
 			if err != nil {
 				return err
 			}
@@ -239,13 +198,13 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 
 	err := x("users", grantRoleToUser, revokeRoleFromUser)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = x("roles", grantRoleToRole, revokeRoleFromRole)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ReadRoleGrants(d, meta)
+	return ReadRoleGrants(ctx, d, meta)
 }
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -14,7 +15,7 @@ func Test_grantToRole(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT ROLE "foo" TO ROLE "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := grantRoleToRole(db, "foo", "bar")
+		err := grantRoleToRole(context.Background(), db, "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -24,31 +25,16 @@ func Test_grantToUser(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT ROLE "foo" TO USER "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := grantRoleToUser(db, "foo", "bar")
+		err := grantRoleToUser(context.Background(), db, "foo", "bar")
 		r.NoError(err)
 	})
 }
 
-func Test_readGrants(t *testing.T) {
-	r := require.New(t)
-
-	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		rows := sqlmock.NewRows([]string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}).AddRow("_", "foo", "ROLE", "bam", "")
-		mock.ExpectQuery(`SHOW GRANTS OF ROLE "foo"`).WillReturnRows(rows)
-		read, err := readGrants(db, "foo")
-		r.NoError(err)
-		r.Len(read, 1)
-		g := read[0]
-		r.Equal("ROLE", g.GrantedTo.String)
-		r.Equal("bam", g.GranteeName.String)
-	})
-}
-
 func Test_revokeRoleFromRole(t *testing.T) {
 	r := require.New(t)
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE ROLE "foo" FROM ROLE "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := revokeRoleFromRole(db, "foo", "bar")
+		err := revokeRoleFromRole(context.Background(), db, "foo", "bar")
 		r.NoError(err)
 
 	})
@@ -58,7 +44,7 @@ func Test_revokeRoleFromUser(t *testing.T) {
 	r := require.New(t)
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE ROLE "foo" FROM USER "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := revokeRoleFromUser(db, "foo", "bar")
+		err := revokeRoleFromUser(context.Background(), db, "foo", "bar")
 		r.NoError(err)
 
 	})
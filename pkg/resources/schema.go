@@ -2,12 +2,14 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -24,7 +26,6 @@ var schemaSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "Specifies the identifier for the schema; must be unique for the database in which the schema is created.",
-		ForceNew:    true,
 	},
 	"database": {
 		Type:        schema.TypeString,
@@ -57,6 +58,8 @@ var schemaSchema = map[string]*schema.Schema{
 		Description:  "Specifies the number of days for which Time Travel actions (CLONE and UNDROP) can be performed on the schema, as well as specifying the default Time Travel retention time for all tables created in the schema.",
 		ValidateFunc: validation.IntBetween(0, 90),
 	},
+	"deletion_protection": deletionProtectionSchema,
+	"recover_if_dropped":  recoverIfDroppedSchema,
 }
 
 type schemaID struct {
@@ -106,10 +109,10 @@ func schemaIDFromString(stringID string) (*schemaID, error) {
 // Schema returns a pointer to the resource representing a schema
 func Schema() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSchema,
-		Read:   ReadSchema,
-		Update: UpdateSchema,
-		Delete: DeleteSchema,
+		CreateContext: CreateSchema,
+		ReadContext:   ReadSchema,
+		UpdateContext: UpdateSchema,
+		DeleteContext: DeleteSchema,
 
 		Schema: schemaSchema,
 		Importer: &schema.ResourceImporter{
@@ -118,14 +121,18 @@ func Schema() *schema.Resource {
 	}
 }
 
-// CreateSchema implements schema.CreateFunc
-func CreateSchema(d *schema.ResourceData, meta interface{}) error {
+// CreateSchema implements schema.CreateContextFunc
+func CreateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 
 	builder := snowflake.Schema(name).WithDB(database)
 
+	if undrop(ctx, d, db, builder.Undrop()) {
+		return recoverSchema(ctx, d, meta)
+	}
+
 	// Set optionals
 	if v, ok := d.GetOk("comment"); ok {
 		builder.WithComment(v.(string))
@@ -145,9 +152,9 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating schema %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating schema %v", name))
 	}
 
 	schemaID := &schemaID{
@@ -156,26 +163,55 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := schemaID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadSchema(d, meta)
+	return ReadSchema(ctx, d, meta)
 }
 
-// ReadSchema implements schema.ReadFunc
-func ReadSchema(d *schema.ResourceData, meta interface{}) error {
+// recoverSchema sets the ID of the schema just restored by UNDROP and alters
+// it to match the configuration, unless it is transient and the configuration
+// says otherwise, or the reverse.
+func recoverSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaID := &schemaID{
+		DatabaseName: d.Get("database").(string),
+		SchemaName:   d.Get("name").(string),
+	}
+	dataIDInput, err := schemaID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	actual := Schema().Data(nil)
+	actual.SetId(dataIDInput)
+	if diags := ReadSchema(ctx, actual, meta); diags.HasError() {
+		return diags
+	}
+	if actual.Id() == "" {
+		return diag.Errorf("schema %v not found after restoring it", dataIDInput)
+	}
+	if err := checkRecovered(actual, d, "is_transient"); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return updateSchema(ctx, d, recoveredChanges{schema: schemaSchema, actual: actual, d: d}, meta)
+}
+
+// ReadSchema implements schema.ReadContextFunc
+func ReadSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := schemaID.DatabaseName
 	schema := schemaID.SchemaName
 
 	q := snowflake.Schema(schema).WithDB(dbName).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRow(ctx, db, q)
 
 	s, err := snowflake.ScanSchema(row)
 	if err == sql.ErrNoRows {
@@ -185,38 +221,38 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", s.DatabaseName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("data_retention_days", s.RetentionTime.Int64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// reset the options before reading back from the DB
 	err = d.Set("is_transient", false)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("is_managed", false)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if opts := s.Options.String; opts != "" {
@@ -225,12 +261,12 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 			case "TRANSIENT":
 				err = d.Set("is_transient", true)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			case "MANAGED ACCESS":
 				err = d.Set("is_managed", true)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -239,11 +275,16 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateSchema implements schema.UpdateFunc
-func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
+// UpdateSchema implements schema.UpdateContextFunc
+func UpdateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return updateSchema(ctx, d, d, meta)
+}
+
+// updateSchema alters the schema of d for the given attribute changes
+func updateSchema(ctx context.Context, d *schema.ResourceData, changes attributeChanges, meta interface{}) diag.Diagnostics {
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := schemaID.DatabaseName
@@ -252,16 +293,32 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 	builder := snowflake.Schema(schema).WithDB(dbName)
 
 	db := meta.(*sql.DB)
-	if d.HasChange("comment") {
+	if name := d.Get("name").(string); name != schema {
+		q := builder.Rename(name)
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error renaming schema %v to %v", d.Id(), name))
+		}
+
+		schemaID.SchemaName = name
+		dataIDInput, err := schemaID.String()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(dataIDInput)
+		builder = snowflake.Schema(name).WithDB(dbName)
+	}
+
+	if changes.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating schema comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating schema comment on %v", d.Id()))
 		}
 	}
 
-	if d.HasChange("is_managed") {
+	if changes.HasChange("is_managed") {
 		managed := d.Get("is_managed")
 		var q string
 		if managed.(bool) {
@@ -270,31 +327,35 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 			q = builder.Unmanage()
 		}
 
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error changing management state on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error changing management state on %v", d.Id()))
 		}
 	}
 
-	if d.HasChange("data_retention_days") {
+	if changes.HasChange("data_retention_days") {
 		days := d.Get("data_retention_days")
 
 		q := builder.ChangeDataRetentionDays(days.(int))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating data retention days on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating data retention days on %v", d.Id()))
 		}
 	}
 
-	return ReadSchema(d, meta)
+	return ReadSchema(ctx, d, meta)
 }
 
-// DeleteSchema implements schema.DeleteFunc
-func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
+// DeleteSchema implements schema.DeleteContextFunc
+func DeleteSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
+	if err := checkDeletionProtection(d, "schema"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := schemaID.DatabaseName
@@ -302,37 +363,12 @@ func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Schema(schema).WithDB(dbName).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting schema %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting schema %v", d.Id()))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-// SchemaExists implements schema.ExistsFunc
-func SchemaExists(data *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	schemaID, err := schemaIDFromString(data.Id())
-	if err != nil {
-		return false, err
-	}
-
-	dbName := schemaID.DatabaseName
-	schema := schemaID.SchemaName
-
-	q := snowflake.Schema(schema).WithDB(dbName).Show()
-	rows, err := db.Query(q)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-
-	return false, nil
-}
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -84,10 +87,10 @@ var schemaGrantSchema = map[string]*schema.Schema{
 func SchemaGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateSchemaGrant,
-			Read:   ReadSchemaGrant,
-			Delete: DeleteSchemaGrant,
-			Update: UpdateSchemaGrant,
+			CreateContext: CreateSchemaGrant,
+			ReadContext:   ReadSchemaGrant,
+			DeleteContext: DeleteSchemaGrant,
+			UpdateContext: UpdateSchemaGrant,
 
 			Schema: schemaGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -98,8 +101,8 @@ func SchemaGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateSchemaGrant implements schema.CreateFunc
-func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateSchemaGrant implements schema.CreateContextFunc
+func CreateSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var schema string
 	if _, ok := d.GetOk("schema_name"); ok {
 		schema = d.Get("schema_name").(string)
@@ -112,7 +115,7 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (schema == "") && !onFuture {
-		return errors.New("schema_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -122,9 +125,9 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SchemaGrant(db, schema)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grantID := &grantID{
@@ -135,15 +138,15 @@ func CreateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grantID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadSchemaGrant(d, meta)
+	return ReadSchemaGrant(ctx, d, meta)
 }
 
-// UpdateSchemaGrant implements schema.UpdateFunc
-func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// UpdateSchemaGrant implements schema.UpdateContextFunc
+func UpdateSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// for now the only thing we can update are roles or shares
 	// if nothing changed, nothing to update and we're done
 	if !d.HasChanges("roles", "shares") {
@@ -173,7 +176,7 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -190,37 +193,39 @@ func UpdateSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 
 	// first revoke
 	err = deleteGenericGrantRolesAndShares(
+		ctx,
 		meta, builder, grantID.Privilege, rolesToRevoke, sharesToRevoke)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then add
 	err = createGenericGrantRolesAndShares(
+		ctx,
 		meta, builder, grantID.Privilege, grantID.GrantOption, rolesToAdd, sharesToAdd)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Done, refresh state
-	return ReadSchemaGrant(d, meta)
+	return ReadSchemaGrant(ctx, d, meta)
 }
 
-// ReadSchemaGrant implements schema.ReadFunc
-func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadSchemaGrant implements schema.ReadContextFunc
+func ReadSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	onFuture := false
 	if schemaName == "" {
@@ -228,15 +233,15 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("on_future", onFuture)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", grantID.Privilege)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -245,14 +250,17 @@ func ReadSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.SchemaGrant(dbName, schemaName)
 	}
-	return readGenericGrant(d, meta, schemaGrantSchema, builder, onFuture, validSchemaPrivileges)
+	if err := readGenericGrant(ctx, d, meta, schemaGrantSchema, builder, onFuture, validSchemaPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteSchemaGrant implements schema.DeleteFunc
-func DeleteSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteSchemaGrant implements schema.DeleteContextFunc
+func DeleteSchemaGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -268,5 +276,8 @@ func DeleteSchemaGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.SchemaGrant(dbName, schemaName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
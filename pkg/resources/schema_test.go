@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,8 +40,8 @@ func TestSchemaCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadSchema(mock)
-		err := resources.CreateSchema(d, db)
-		r.NoError(err)
+		diags := resources.CreateSchema(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -59,9 +61,96 @@ func TestSchemaRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Schema("good_name").WithDB("test_db").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadSchema(d, db)
+		diags := resources.ReadSchema(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
+	})
+}
+
+func TestSchemaUpdateRename(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.Schema()
+	state := &terraform.InstanceState{
+		ID: "test_db|old_name",
+		Attributes: map[string]string{
+			"name":                "old_name",
+			"database":            "test_db",
+			"is_transient":        "true",
+			"is_managed":          "true",
+			"comment":             "great comment",
+			"data_retention_days": "1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":         "good_name",
+		"database":     "test_db",
+		"is_transient": true,
+		"is_managed":   true,
+		"comment":      "great comment",
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	r.False(diff.RequiresNew())
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SCHEMA "test_db"."old_name" RENAME TO "test_db"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSchema(mock)
+		diags := resources.UpdateSchema(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|good_name", d.Id())
+	})
+}
+
+func TestSchemaCreateRecover(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                "good_name",
+		"database":            "test_db",
+		"comment":             "other comment",
+		"is_transient":        true,
+		"is_managed":          false,
+		"data_retention_days": 7,
+		"recover_if_dropped":  true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Schema().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^UNDROP SCHEMA "test_db"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSchema(mock)
+		mock.ExpectExec(`^ALTER SCHEMA "test_db"."good_name" SET COMMENT = 'other comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER SCHEMA "test_db"."good_name" DISABLE MANAGED ACCESS$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER SCHEMA "test_db"."good_name" SET DATA_RETENTION_TIME_IN_DAYS = 7$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSchema(mock)
+		diags := resources.CreateSchema(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|good_name", d.Id())
+	})
+}
+
+func TestSchemaCreateRecoverPermanent(t *testing.T) {
+	r := require.New(t)
+
+	// the restored schema is transient, so it would be replaced right away
+	in := map[string]interface{}{
+		"name":               "good_name",
+		"database":           "test_db",
+		"recover_if_dropped": true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.Schema().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^UNDROP SCHEMA "test_db"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSchema(mock)
+		diags := resources.CreateSchema(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, "the restored good_name has is_transient = true but the configuration sets false")
+		r.Empty(d.Id())
 	})
 }
 
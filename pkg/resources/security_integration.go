@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -87,10 +89,10 @@ var securityIntegrationSchema = map[string]*schema.Schema{
 // SecurityIntegration returns a pointer to the resource representing a security integration
 func SecurityIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateSecurityIntegration,
-		Read:   ReadSecurityIntegration,
-		Update: UpdateSecurityIntegration,
-		Delete: DeleteSecurityIntegration,
+		CreateContext: CreateSecurityIntegration,
+		ReadContext:   ReadSecurityIntegration,
+		UpdateContext: UpdateSecurityIntegration,
+		DeleteContext: DeleteSecurityIntegration,
 
 		Schema: securityIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -99,8 +101,8 @@ func SecurityIntegration() *schema.Resource {
 	}
 }
 
-// CreateSecurityIntegration implements schema.CreateFunc
-func CreateSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateSecurityIntegration implements schema.CreateContextFunc
+func CreateSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -117,42 +119,45 @@ func CreateSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
 	// Now, set the security provider
 	err := setSecurityProviderSettings(d, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, stmt.Statement())
+	err = snowflake.Exec(ctx, db, stmt.Statement())
 	if err != nil {
-		return fmt.Errorf("error creating security integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating security integration: %w", err))
 	}
 
 	d.SetId(name)
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 	//return ReadSecurityIntegration(d, meta)
 }
 
-// ReadSecurityIntegration implements schema.ReadFunc
-func ReadSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadSecurityIntegration implements schema.ReadContextFunc
+func ReadSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.SecurityIntegration(d.Id()).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanSecurityIntegration(row)
 	if err != nil {
-		return fmt.Errorf("Could not show security integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not show security integration: %w", err))
 	}
 
 	// Note: category must be STORAGE or something is broken
 	if c := s.Category.String; c != "SECURITY" {
-		return fmt.Errorf("Expected %v to be a SECURITY integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("Expected %v to be a SECURITY integration, got %v", id, c))
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// securityType := strings.Split(s.IntegrationType.String, "-")[0]
@@ -165,11 +170,11 @@ func ReadSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
 	// }
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Some properties come from the DESCRIBE INTEGRATION call
@@ -177,62 +182,65 @@ func ReadSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.SecurityIntegration(d.Id()).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("Could not describe security integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not describe security integration: %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		switch k {
 		case "ENABLED":
 			// We set this using the SHOW INTEGRATION call so let's ignore it here
 		case "EXTERNAL_OAUTH_ISSUER":
 			if err = d.Set("external_oauth_issuer", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "EXTERNAL_OAUTH_TOKEN_USER_MAPPING_CLAIM":
 			if err = d.Set("external_oauth_token_user_mapping_claim", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "EXTERNAL_OAUTH_SNOWFLAKE_USER_MAPPING_ATTRIBUTE":
 			if err = d.Set("external_oauth_snowflake_user_mapping_attribute", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "EXTERNAL_OAUTH_JWS_KEYS_URL":
 			if err = d.Set("external_oauth_jws_keys_url", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY":
 			if err = d.Set("external_oauth_rsa_public_key", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "EXTERNAL_OAUTH_RSA_PUBLIC_KEY_2":
 			if err = d.Set("external_oauth_rsa_public_key_2", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		case "EXTERNAL_OAUTH_AUDIENCE_LIST":
 			if val := v.(string); val != "" {
 				if err = d.Set("external_oauth_audience_list", strings.Split(val, ",")); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		case "EXTERNAL_OAUTH_ANY_ROLE_MODE":
 			if err = d.Set("external_oauth_any_role_mode", v.(string)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		default:
 			log.Printf("[WARN] unexpected property %v returned from Snowflake", k)
 		}
 	}
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// UpdateSecurityIntegration implements schema.UpdateFunc
-func UpdateSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateSecurityIntegration implements schema.UpdateContextFunc
+func UpdateSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -256,7 +264,7 @@ func UpdateSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
 		runSetStatement = true
 		err := setSecurityProviderSettings(d, stmt)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if d.HasChange("external_oauth_type") {
@@ -290,9 +298,9 @@ func UpdateSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
 		if d.HasChange("external_oauth_audience_list") {
 			v := d.Get("external_oauth_audience_list").([]interface{})
 			if len(v) == 0 {
-				err := snowflake.Exec(db, fmt.Sprintf(`ALTER SECURITY INTEGRATION %v UNSET EXTERNAL_OAUTH_AUDIENCE_LIST`, d.Id()))
+				err := snowflake.Exec(ctx, db, fmt.Sprintf(`ALTER SECURITY INTEGRATION %v UNSET EXTERNAL_OAUTH_AUDIENCE_LIST`, d.Id()))
 				if err != nil {
-					return fmt.Errorf("error unsetting external_oauth_audience_list %w", err)
+					return diag.FromErr(fmt.Errorf("error unsetting external_oauth_audience_list %w", err))
 				}
 			} else {
 				runSetStatement = true
@@ -306,35 +314,17 @@ func UpdateSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating security integration: %w", err)
+		if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating security integration: %w", err))
 		}
 	}
 
-	return ReadSecurityIntegration(d, meta)
-}
-
-// DeleteSecurityIntegration implements schema.DeleteFunc
-func DeleteSecurityIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.SecurityIntegration)(d, meta)
+	return ReadSecurityIntegration(ctx, d, meta)
 }
 
-// SecurityIntegrationExists implements schema.ExistsFunc
-func SecurityIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.SecurityIntegration(id).Show()
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-	return false, nil
+// DeleteSecurityIntegration implements schema.DeleteContextFunc
+func DeleteSecurityIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.SecurityIntegration)(ctx, d, meta)
 }
 
 func setSecurityProviderSettings(data *schema.ResourceData, stmt snowflake.SettingBuilder) error {
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -66,9 +69,9 @@ var sequenceGrantSchema = map[string]*schema.Schema{
 func SequenceGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateSequenceGrant,
-			Read:   ReadSequenceGrant,
-			Delete: DeleteSequenceGrant,
+			CreateContext: CreateSequenceGrant,
+			ReadContext:   ReadSequenceGrant,
+			DeleteContext: DeleteSequenceGrant,
 
 			Schema: sequenceGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -79,8 +82,8 @@ func SequenceGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateSequenceGrant implements schema.CreateFunc
-func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateSequenceGrant implements schema.CreateContextFunc
+func CreateSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var sequenceName string
 	if name, ok := d.GetOk("sequence_name"); ok {
 		sequenceName = name.(string)
@@ -92,10 +95,10 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (sequenceName == "") && !futureSequences {
-		return errors.New("sequence_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("sequence_name must be set unless on_future is true."))
 	}
 	if (sequenceName != "") && futureSequences {
-		return errors.New("sequence_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("sequence_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -105,9 +108,9 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SequenceGrant(dbName, schemaName, sequenceName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -119,18 +122,18 @@ func CreateSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadSequenceGrant(d, meta)
+	return ReadSequenceGrant(ctx, d, meta)
 }
 
-// ReadSequenceGrant implements schema.ReadFunc
-func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadSequenceGrant implements schema.ReadContextFunc
+func ReadSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -139,11 +142,11 @@ func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureSequencesEnabled := false
 	if sequenceName == "" {
@@ -151,19 +154,19 @@ func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("sequence_name", sequenceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureSequencesEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -173,14 +176,17 @@ func ReadSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.SequenceGrant(dbName, schemaName, sequenceName)
 	}
 
-	return readGenericGrant(d, meta, sequenceGrantSchema, builder, futureSequencesEnabled, validSequencePrivileges)
+	if err := readGenericGrant(ctx, d, meta, sequenceGrantSchema, builder, futureSequencesEnabled, validSequencePrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteSequenceGrant implements schema.DeleteFunc
-func DeleteSequenceGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteSequenceGrant implements schema.DeleteContextFunc
+func DeleteSequenceGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -194,5 +200,8 @@ func DeleteSequenceGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.SequenceGrant(dbName, schemaName, sequenceName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,8 +38,8 @@ func TestSequenceGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON SEQUENCE "test-db"."PUBLIC"."test-sequence" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON SEQUENCE "test-db"."PUBLIC"."test-sequence" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSequenceGrant(mock)
-		err := resources.CreateSequenceGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateSequenceGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -58,8 +59,8 @@ func TestSequenceGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadSequenceGrant(mock)
-		err := resources.ReadSequenceGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadSequenceGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -101,8 +102,8 @@ func TestFutureSequenceGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE SEQUENCES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureSequenceGrant(mock)
-		err := resources.CreateSequenceGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateSequenceGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -125,8 +126,8 @@ func TestFutureSequenceGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE SEQUENCES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureSequenceDatabaseGrant(mock)
-		err := resources.CreateSequenceGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateSequenceGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
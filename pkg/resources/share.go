@@ -1,12 +1,14 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 
@@ -41,10 +43,10 @@ var shareSchema = map[string]*schema.Schema{
 // Share returns a pointer to the resource representing a share
 func Share() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateShare,
-		Read:   ReadShare,
-		Update: UpdateShare,
-		Delete: DeleteShare,
+		CreateContext: CreateShare,
+		ReadContext:   ReadShare,
+		UpdateContext: UpdateShare,
+		DeleteContext: DeleteShare,
 
 		Schema: shareSchema,
 		Importer: &schema.ResourceImporter{
@@ -53,17 +55,17 @@ func Share() *schema.Resource {
 	}
 }
 
-// CreateShare implements schema.CreateFunc
-func CreateShare(d *schema.ResourceData, meta interface{}) error {
+// CreateShare implements schema.CreateContextFunc
+func CreateShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
 	builder := snowflake.Share(name).Create()
 	builder.SetString("COMMENT", d.Get("comment").(string))
 
-	err := snowflake.Exec(db, builder.Statement())
+	err := snowflake.Exec(ctx, db, builder.Statement())
 	if err != nil {
-		return errors.Wrapf(err, "error creating share")
+		return diag.FromErr(errors.Wrapf(err, "error creating share"))
 	}
 	d.SetId(name)
 
@@ -71,15 +73,15 @@ func CreateShare(d *schema.ResourceData, meta interface{}) error {
 
 	// @TODO flesh out the share type in the snowflake package since it doesn't
 	// follow the normal generic rules
-	err = setAccounts(d, meta)
+	err = setAccounts(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ReadShare(d, meta)
+	return ReadShare(ctx, d, meta)
 }
 
-func setAccounts(d *schema.ResourceData, meta interface{}) error {
+func setAccounts(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	accs := expandStringList(d.Get("accounts").([]interface{}))
@@ -92,30 +94,30 @@ func setAccounts(d *schema.ResourceData, meta interface{}) error {
 		// 1. Create new temporary DB
 		tempName := fmt.Sprintf("TEMP_%v_%d", name, time.Now().Unix())
 		tempDB := snowflake.Database(tempName)
-		err := snowflake.Exec(db, tempDB.Create().Statement())
+		err := snowflake.Exec(ctx, db, tempDB.Create().Statement())
 		if err != nil {
 			return errors.Wrapf(err, "error creating temporary DB %v", tempName)
 		}
 
 		// 2. Create temporary DB grant to the share
 		tempDBGrant := snowflake.DatabaseGrant(tempName)
-		err = snowflake.Exec(db, tempDBGrant.Share(name).Grant("USAGE", false))
+		err = snowflake.Exec(ctx, db, tempDBGrant.Share(name).Grant("USAGE", false))
 		if err != nil {
 			return errors.Wrapf(err, "error creating temporary DB grant %v", tempName)
 		}
 		// 3. Add the accounts to the share
 		q := fmt.Sprintf(`ALTER SHARE "%v" SET ACCOUNTS=%v`, name, strings.Join(accs, ","))
-		err = snowflake.Exec(db, q)
+		err = snowflake.Exec(ctx, db, q)
 		if err != nil {
 			return errors.Wrapf(err, "error adding accounts to share %v", name)
 		}
 		// 4. Revoke temporary DB grant to the share
-		err = snowflake.ExecMulti(db, tempDBGrant.Share(name).Revoke("USAGE"))
+		err = snowflake.ExecMulti(ctx, db, tempDBGrant.Share(name).Revoke("USAGE"))
 		if err != nil {
 			return errors.Wrapf(err, "error revoking temporary DB grant %v", tempName)
 		}
 		// 5. Remove the temporary DB
-		err = snowflake.Exec(db, tempDB.Drop())
+		err = snowflake.Exec(ctx, db, tempDB.Drop())
 		if err != nil {
 			return errors.Wrapf(err, "error dropping temporary DB %v", tempName)
 		}
@@ -124,13 +126,13 @@ func setAccounts(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// ReadShare implements schema.ReadFunc
-func ReadShare(d *schema.ResourceData, meta interface{}) error {
+// ReadShare implements schema.ReadContextFunc
+func ReadShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.Share(id).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	s, err := snowflake.ScanShare(row)
 	if err == sql.ErrNoRows {
@@ -140,58 +142,43 @@ func ReadShare(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", StripAccountFromName(s.Name.String))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("comment", s.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accs := strings.FieldsFunc(s.To.String, func(c rune) bool { return c == ',' })
 	err = d.Set("accounts", accs)
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// UpdateShare implements schema.UpdateFunc
-func UpdateShare(d *schema.ResourceData, meta interface{}) error {
+// UpdateShare implements schema.UpdateContextFunc
+func UpdateShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Change the accounts first - this is a special case and won't work using the generic method
 	if d.HasChange("accounts") {
-		err := setAccounts(d, meta)
+		err := setAccounts(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return UpdateResource("this does not seem to be used", shareProperties, shareSchema, snowflake.Share, ReadShare)(d, meta)
+	return UpdateResource("this does not seem to be used", shareProperties, shareSchema, snowflake.Share, ReadShare)(ctx, d, meta)
 }
 
-// DeleteShare implements schema.DeleteFunc
-func DeleteShare(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("this does not seem to be used", snowflake.Share)(d, meta)
-}
-
-// ShareExists implements schema.ExistsFunc
-func ShareExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.Share(id).Show()
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-	return false, nil
+// DeleteShare implements schema.DeleteContextFunc
+func DeleteShare(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("this does not seem to be used", snowflake.Share)(ctx, d, meta)
 }
 
 // StripAccountFromName removes the accout prefix from a resource (e.g. a share)
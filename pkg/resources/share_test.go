@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -43,8 +44,8 @@ func TestShareCreate(t *testing.T) {
 
 		mock.ExpectExec(`^DROP DATABASE "TEMP_test-share_\d*"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadShare(mock)
-		err := resources.CreateShare(d, db)
-		r.NoError(err)
+		diags := resources.CreateShare(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -81,8 +82,8 @@ func TestShareRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Share(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadShare(d, db)
+		diags := resources.ReadShare(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -22,7 +24,6 @@ var stageSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 		Description: "Specifies the identifier for the stage; must be unique for the database and schema in which the stage is created.",
-		ForceNew:    true,
 	},
 	"database": {
 		Type:        schema.TypeString,
@@ -82,6 +83,7 @@ var stageSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 	},
+	"deletion_protection": deletionProtectionSchema,
 }
 
 type stageID struct {
@@ -133,10 +135,10 @@ func stageIDFromString(stringID string) (*stageID, error) {
 // Stage returns a pointer to the resource representing a stage
 func Stage() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateStage,
-		Read:   ReadStage,
-		Update: UpdateStage,
-		Delete: DeleteStage,
+		CreateContext: CreateStage,
+		ReadContext:   ReadStage,
+		UpdateContext: UpdateStage,
+		DeleteContext: DeleteStage,
 
 		Schema: stageSchema,
 		Importer: &schema.ResourceImporter{
@@ -145,8 +147,8 @@ func Stage() *schema.Resource {
 	}
 }
 
-// CreateStage implements schema.CreateFunc
-func CreateStage(d *schema.ResourceData, meta interface{}) error {
+// CreateStage implements schema.CreateContextFunc
+func CreateStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
@@ -185,9 +187,9 @@ func CreateStage(d *schema.ResourceData, meta interface{}) error {
 
 	q := builder.Create()
 
-	err := snowflake.Exec(db, q)
+	err := snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating stage %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating stage %v", name))
 	}
 
 	stageID := &stageID{
@@ -197,20 +199,20 @@ func CreateStage(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := stageID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadStage(d, meta)
+	return ReadStage(ctx, d, meta)
 }
 
-// ReadStage implements schema.ReadFunc
+// ReadStage implements schema.ReadContextFunc
 // credentials and encryption are omitted, they cannot be read via SHOW or DESCRIBE
-func ReadStage(d *schema.ResourceData, meta interface{}) error {
+func ReadStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := stageID.DatabaseName
@@ -218,7 +220,7 @@ func ReadStage(d *schema.ResourceData, meta interface{}) error {
 	stage := stageID.StageName
 
 	q := snowflake.Stage(stage, dbName, schema).Describe()
-	stageDesc, err := snowflake.DescStage(db, q)
+	stageDesc, err := snowflake.DescStage(ctx, db, q)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
 		log.Printf("[DEBUG] stage (%s) not found", d.Id())
@@ -226,75 +228,75 @@ func ReadStage(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sq := snowflake.Stage(stage, dbName, schema).Show()
-	row := snowflake.QueryRow(db, sq)
+	row := snowflake.QueryRow(ctx, db, sq)
 
 	s, err := snowflake.ScanStageShow(row)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", s.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", s.DatabaseName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", s.SchemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("url", stageDesc.Url)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("file_format", stageDesc.FileFormat)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("copy_options", stageDesc.CopyOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("storage_integration", s.StorageIntegration)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", s.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("aws_external_id", stageDesc.AwsExternalID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("snowflake_iam_user", stageDesc.SnowflakeIamUser)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// UpdateStage implements schema.UpdateFunc
-func UpdateStage(d *schema.ResourceData, meta interface{}) error {
+// UpdateStage implements schema.UpdateContextFunc
+func UpdateStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := stageID.DatabaseName
@@ -304,75 +306,95 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 	builder := snowflake.Stage(stage, dbName, schema)
 
 	db := meta.(*sql.DB)
+	if name := d.Get("name").(string); name != stage {
+		q := builder.Rename(name)
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error renaming stage %v to %v", d.Id(), name))
+		}
+
+		stageID.StageName = name
+		dataIDInput, err := stageID.String()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(dataIDInput)
+		builder = snowflake.Stage(name, dbName, schema)
+	}
+
 	if d.HasChange("url") {
 		url := d.Get("url")
 		q := builder.ChangeURL(url.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage url on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage url on %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("credentials") {
 		credentials := d.Get("credentials")
 		q := builder.ChangeCredentials(credentials.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage credentials on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage credentials on %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("storage_integration") {
 		si := d.Get("storage_integration")
 		q := builder.ChangeStorageIntegration(si.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage storage integration on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage storage integration on %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("encryption") {
 		encryption := d.Get("encryption")
 		q := builder.ChangeEncryption(encryption.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage encryption on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage encryption on %v", d.Id()))
 		}
 	}
 	if d.HasChange("file_format") {
 		fileFormat := d.Get("file_format")
 		q := builder.ChangeFileFormat(fileFormat.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage file formaat on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage file formaat on %v", d.Id()))
 		}
 	}
 	if d.HasChange("copy_options") {
 		copyOptions := d.Get("copy_options")
 		q := builder.ChangeCopyOptions(copyOptions.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage copy options on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage copy options on %v", d.Id()))
 		}
 	}
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stage comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stage comment on %v", d.Id()))
 		}
 	}
 
-	return ReadStage(d, meta)
+	return ReadStage(ctx, d, meta)
 }
 
-// DeleteStage implements schema.DeleteFunc
-func DeleteStage(d *schema.ResourceData, meta interface{}) error {
+// DeleteStage implements schema.DeleteContextFunc
+func DeleteStage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
+	if err := checkDeletionProtection(d, "stage"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := stageID.DatabaseName
@@ -381,38 +403,12 @@ func DeleteStage(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Stage(stage, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting stage %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting stage %v", d.Id()))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-// StageExists implements schema.ExistsFunc
-func StageExists(data *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	stageID, err := stageIDFromString(data.Id())
-	if err != nil {
-		return false, err
-	}
-
-	dbName := stageID.DatabaseName
-	schema := stageID.SchemaName
-	stage := stageID.StageName
-
-	q := snowflake.Stage(stage, dbName, schema).Describe()
-	rows, err := db.Query(q)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-
-	return false, nil
-}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -38,8 +39,8 @@ func TestInternalStageCreate(t *testing.T) {
 
 		expectReadStage(mock)
 		expectReadStageShow(mock)
-		err := resources.CreateStage(d, db)
-		r.NoError(err)
+		diags := resources.CreateStage(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -63,8 +64,8 @@ func TestExternalStageCreate(t *testing.T) {
 
 		expectReadStage(mock)
 		expectReadStageShow(mock)
-		err := resources.CreateStage(d, db)
-		r.NoError(err)
+		diags := resources.CreateStage(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -84,6 +85,26 @@ func expectReadStageShow(mock sqlmock.Sqlmock) {
 	mock.ExpectQuery(`^SHOW STAGES LIKE 'test_stage' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
 }
 
+func TestStageUpdateRename(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "test_stage",
+		"database": "test_db",
+		"schema":   "test_schema",
+	}
+	d := stage(t, "test_db|test_schema|old_stage", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER STAGE "test_db"."test_schema"."old_stage" RENAME TO "test_db"."test_schema"."test_stage"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadStage(mock)
+		expectReadStageShow(mock)
+		diags := resources.UpdateStage(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("test_db|test_schema|test_stage", d.Id())
+	})
+}
+
 func TestStageRead(t *testing.T) {
 	r := require.New(t)
 
@@ -99,8 +120,8 @@ func TestStageRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Stage("test_stage", "test_db", "test_schema").Describe()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadStage(d, db)
+		diags := resources.ReadStage(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
@@ -1,10 +1,12 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -82,10 +84,10 @@ var storageIntegrationSchema = map[string]*schema.Schema{
 // StorageIntegration returns a pointer to the resource representing a storage integration
 func StorageIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateStorageIntegration,
-		Read:   ReadStorageIntegration,
-		Update: UpdateStorageIntegration,
-		Delete: DeleteStorageIntegration,
+		CreateContext: CreateStorageIntegration,
+		ReadContext:   ReadStorageIntegration,
+		UpdateContext: UpdateStorageIntegration,
+		DeleteContext: DeleteStorageIntegration,
 
 		Schema: storageIntegrationSchema,
 		Importer: &schema.ResourceImporter{
@@ -94,8 +96,8 @@ func StorageIntegration() *schema.Resource {
 	}
 }
 
-// CreateStorageIntegration implements schema.CreateFunc
-func CreateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
+// CreateStorageIntegration implements schema.CreateContextFunc
+func CreateStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 
@@ -119,42 +121,42 @@ func CreateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	// Now, set the storage provider
 	err := setStorageProviderSettings(d, stmt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, stmt.Statement())
+	err = snowflake.Exec(ctx, db, stmt.Statement())
 	if err != nil {
-		return fmt.Errorf("error creating storage integration: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating storage integration: %w", err))
 	}
 
 	d.SetId(name)
 
 	//return err
-	return ReadStorageIntegration(d, meta)
+	return ReadStorageIntegration(ctx, d, meta)
 }
 
-// ReadStorageIntegration implements schema.ReadFunc
-func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
+// ReadStorageIntegration implements schema.ReadContextFunc
+func ReadStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
 	stmt := snowflake.StorageIntegration(d.Id()).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 
 	// Some properties can come from the SHOW INTEGRATION call
 
 	s, err := snowflake.ScanStorageIntegration(row)
 	if err != nil {
-		return fmt.Errorf("Could not show storage integration %w", err)
+		return diag.FromErr(fmt.Errorf("Could not show storage integration %w", err))
 	}
 
 	// Note: category must be STORAGE or something is broken
 	if c := s.Category.String; c != "STORAGE" {
-		return fmt.Errorf("Expected %v to be a STORAGE integration, got %v", id, c)
+		return diag.FromErr(fmt.Errorf("Expected %v to be a STORAGE integration, got %v", id, c))
 	}
 
 	if err := d.Set("name", s.Name.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// if err := d.Set("type", s.IntegrationType.String); err != nil {
@@ -162,11 +164,11 @@ func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	// }
 
 	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// --- THE block below must change validation only , see stage.go
@@ -176,14 +178,14 @@ func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	var k, pType string
 	var v, unused interface{}
 	stmt = snowflake.StorageIntegration(d.Id()).Describe()
-	rows, err := db.Query(stmt)
+	rows, err := db.QueryContext(ctx, stmt)
 	if err != nil {
-		return fmt.Errorf("Could not describe storage integration: %w", err)
+		return diag.FromErr(fmt.Errorf("Could not describe storage integration: %w", err))
 	}
 	defer rows.Close()
 	for rows.Next() {
 		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// 	switch k {
 		// 	case "ENABLED":
@@ -219,11 +221,14 @@ func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 		// 	}
 	}
 
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// UpdateStorageIntegration implements schema.UpdateFunc
-func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
+// UpdateStorageIntegration implements schema.UpdateContextFunc
+func UpdateStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	id := d.Id()
 
@@ -255,9 +260,9 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("storage_blocked_locations") {
 		v := d.Get("storage_blocked_locations").([]interface{})
 		if len(v) == 0 {
-			err := snowflake.Exec(db, fmt.Sprintf(`ALTER STORAGE INTEGRATION %v UNSET STORAGE_BLOCKED_LOCATIONS`, d.Id()))
+			err := snowflake.Exec(ctx, db, fmt.Sprintf(`ALTER STORAGE INTEGRATION %v UNSET STORAGE_BLOCKED_LOCATIONS`, d.Id()))
 			if err != nil {
-				return fmt.Errorf("error unsetting storage_blocked_locations: %w", err)
+				return diag.FromErr(fmt.Errorf("error unsetting storage_blocked_locations: %w", err))
 			}
 		} else {
 			runSetStatement = true
@@ -269,7 +274,7 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 		runSetStatement = true
 		err := setStorageProviderSettings(d, stmt)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if d.HasChange("storage_aws_role_arn") {
@@ -283,35 +288,17 @@ func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if runSetStatement {
-		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
-			return fmt.Errorf("error updating storage integration: %w", err)
+		if err := snowflake.Exec(ctx, db, stmt.Statement()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating storage integration: %w", err))
 		}
 	}
 
-	return ReadStorageIntegration(d, meta)
+	return ReadStorageIntegration(ctx, d, meta)
 }
 
-// DeleteStorageIntegration implements schema.DeleteFunc
-func DeleteStorageIntegration(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("", snowflake.StorageIntegration)(d, meta)
-}
-
-// StorageIntegrationExists implements schema.ExistsFunc
-func StorageIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	id := d.Id()
-
-	stmt := snowflake.StorageIntegration(id).Show()
-	rows, err := db.Query(stmt)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-	return false, nil
+// DeleteStorageIntegration implements schema.DeleteContextFunc
+func DeleteStorageIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("", snowflake.StorageIntegration)(ctx, d, meta)
 }
 
 func setStorageProviderSettings(data *schema.ResourceData, stmt snowflake.SettingBuilder) error {
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -37,8 +38,8 @@ func TestStorageIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadStorageIntegration(mock)
 
-		err := resources.CreateStorageIntegration(d, db)
-		r.NoError(err)
+		diags := resources.CreateStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -50,8 +51,8 @@ func TestStorageIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStorageIntegration(mock)
 
-		err := resources.ReadStorageIntegration(d, db)
-		r.NoError(err)
+		diags := resources.ReadStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -62,8 +63,8 @@ func TestStorageIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP STORAGE INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteStorageIntegration(d, db)
-		r.NoError(err)
+		diags := resources.DeleteStorageIntegration(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -62,10 +64,10 @@ var streamSchema = map[string]*schema.Schema{
 
 func Stream() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateStream,
-		Read:   ReadStream,
-		Update: UpdateStream,
-		Delete: DeleteStream,
+		CreateContext: CreateStream,
+		ReadContext:   ReadStream,
+		UpdateContext: UpdateStream,
+		DeleteContext: DeleteStream,
 
 		Schema: streamSchema,
 		Importer: &schema.ResourceImporter{
@@ -152,8 +154,8 @@ func streamOnTableIDFromString(stringID string) (*streamOnTableID, error) {
 	return streamOnTableResult, nil
 }
 
-// CreateStream implements schema.CreateFunc
-func CreateStream(d *schema.ResourceData, meta interface{}) error {
+// CreateStream implements schema.CreateContextFunc
+func CreateStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -165,7 +167,7 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 
 	resultOnTable, err := streamOnTableIDFromString(onTable)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder.WithOnTable(resultOnTable.DatabaseName, resultOnTable.SchemaName, resultOnTable.OnTableName)
@@ -177,9 +179,9 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stmt := builder.Create()
-	err = snowflake.Exec(db, stmt)
+	err = snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating stream %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating stream %v", name))
 	}
 
 	streamID := &streamID{
@@ -189,19 +191,19 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := streamID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadStream(d, meta)
+	return ReadStream(ctx, d, meta)
 }
 
-// ReadStream implements schema.ReadFunc
-func ReadStream(d *schema.ResourceData, meta interface{}) error {
+// ReadStream implements schema.ReadContextFunc
+func ReadStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := streamID.DatabaseName
@@ -209,7 +211,7 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 	name := streamID.StreamName
 
 	stmt := snowflake.Stream(name, dbName, schema).Show()
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 	stream, err := snowflake.ScanStream(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -218,28 +220,28 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", stream.StreamName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("owner", stream.Owner.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-// DeleteStream implements schema.DeleteFunc
-func DeleteStream(d *schema.ResourceData, meta interface{}) error {
+// DeleteStream implements schema.DeleteContextFunc
+func DeleteStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := streamID.DatabaseName
@@ -248,9 +250,9 @@ func DeleteStream(d *schema.ResourceData, meta interface{}) error {
 
 	q := snowflake.Stream(streamName, dbName, schema).Drop()
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting stream %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting stream %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -258,11 +260,11 @@ func DeleteStream(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// UpdateStream implements schema.UpdateFunc
-func UpdateStream(d *schema.ResourceData, meta interface{}) error {
+// UpdateStream implements schema.UpdateContextFunc
+func UpdateStream(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := streamID.DatabaseName
@@ -275,11 +277,11 @@ func UpdateStream(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating stream comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating stream comment on %v", d.Id()))
 		}
 	}
 
-	return ReadStream(d, meta)
+	return ReadStream(ctx, d, meta)
 }
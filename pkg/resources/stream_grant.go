@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -66,9 +69,9 @@ var streamGrantSchema = map[string]*schema.Schema{
 func StreamGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateStreamGrant,
-			Read:   ReadStreamGrant,
-			Delete: DeleteStreamGrant,
+			CreateContext: CreateStreamGrant,
+			ReadContext:   ReadStreamGrant,
+			DeleteContext: DeleteStreamGrant,
 
 			Schema: streamGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -79,8 +82,8 @@ func StreamGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateStreamGrant implements schema.CreateFunc
-func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateStreamGrant implements schema.CreateContextFunc
+func CreateStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var streamName string
 	if name, ok := d.GetOk("stream_name"); ok {
 		streamName = name.(string)
@@ -92,10 +95,10 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (streamName == "") && !futureStreams {
-		return errors.New("stream_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("stream_name must be set unless on_future is true."))
 	}
 	if (streamName != "") && futureStreams {
-		return errors.New("stream_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("stream_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -105,9 +108,9 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StreamGrant(dbName, schemaName, streamName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -119,18 +122,18 @@ func CreateStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadStreamGrant(d, meta)
+	return ReadStreamGrant(ctx, d, meta)
 }
 
-// ReadStreamGrant implements schema.ReadFunc
-func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadStreamGrant implements schema.ReadContextFunc
+func ReadStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -139,11 +142,11 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureStreamsEnabled := false
 	if streamName == "" {
@@ -151,19 +154,19 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("stream_name", streamName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureStreamsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -173,14 +176,17 @@ func ReadStreamGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.StreamGrant(dbName, schemaName, streamName)
 	}
 
-	return readGenericGrant(d, meta, streamGrantSchema, builder, futureStreamsEnabled, validStreamPrivileges)
+	if err := readGenericGrant(ctx, d, meta, streamGrantSchema, builder, futureStreamsEnabled, validStreamPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteStreamGrant implements schema.DeleteFunc
-func DeleteStreamGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteStreamGrant implements schema.DeleteContextFunc
+func DeleteStreamGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -194,5 +200,8 @@ func DeleteStreamGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.StreamGrant(dbName, schemaName, streamName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -37,8 +38,8 @@ func TestStreamGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadStreamGrant(mock)
-		err := resources.CreateStreamGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateStreamGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -58,8 +59,8 @@ func TestStreamGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStreamGrant(mock)
-		err := resources.ReadStreamGrant(d, db)
-		r.NoError(err)
+		diags := resources.ReadStreamGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	roles := d.Get("roles").(*schema.Set)
@@ -101,8 +102,8 @@ func TestFutureStreamGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE STREAMS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStreamGrant(mock)
-		err := resources.CreateStreamGrant(d, db)
-		r.NoError(err)
+		diags := resources.CreateStreamGrant(context.Background(), d, db)
+		r.Empty(diags)
 	})
 
 	b := require.New(t)
@@ -125,8 +126,8 @@ func TestFutureStreamGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE STREAMS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStreamDatabaseGrant(mock)
-		err := resources.CreateStreamGrant(d, db)
-		b.NoError(err)
+		diags := resources.CreateStreamGrant(context.Background(), d, db)
+		b.Empty(diags)
 	})
 }
 
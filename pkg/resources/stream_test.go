@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -34,8 +35,8 @@ func TestStreamCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE STREAM "database_name"."schema_name"."stream_name" ON TABLE "target_db"."target_schema"."target_table" COMMENT = 'great comment' APPEND_ONLY = true`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
-		err := resources.CreateStream(d, db)
-		r.NoError(err)
+		diags := resources.CreateStream(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("stream_name", d.Get("name").(string))
 	})
 }
@@ -52,8 +53,8 @@ func TestStreamRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectStreamRead(mock)
-		err := resources.ReadStream(d, db)
-		r.NoError(err)
+		diags := resources.ReadStream(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("stream_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
 
@@ -61,9 +62,9 @@ func TestStreamRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Stream("stream_name", "database_name", "schema_name").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadStream(d, db)
+		diags2 := resources.ReadStream(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
 	})
 }
 
@@ -74,8 +75,8 @@ func TestStreamDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP STREAM "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteStream(d, db)
-		r.NoError(err)
+		diags := resources.DeleteStream(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -96,7 +97,7 @@ func TestStreamUpdate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER STREAM "database_name"."schema_name"."stream_name" SET COMMENT = 'new stream comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
-		err := resources.UpdateStream(d, db)
-		r.NoError(err)
+		diags := resources.UpdateStream(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
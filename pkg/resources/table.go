@@ -2,14 +2,20 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 )
 
@@ -17,11 +23,26 @@ const (
 	tableIDDelimiter = '|'
 )
 
+// tableKeySchema is the schema of the primary_key and unique_key blocks
+var tableKeySchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the constraint.",
+	},
+	"keys": {
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Columns of the constraint.",
+	},
+}
+
 var tableSchema = map[string]*schema.Schema{
 	"name": {
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 		Description: "Specifies the identifier for the table; must be unique for the database and schema in which the table is created.",
 	},
 	"schema": {
@@ -38,10 +59,10 @@ var tableSchema = map[string]*schema.Schema{
 	},
 	"column": {
 		Type:        schema.TypeList,
-		Required:    true,
+		Optional:    true,
+		Computed:    true,
 		MinItems:    1,
-		ForceNew:    true,
-		Description: "Definitions of a column to create in the table. Minimum one required.",
+		Description: "Definitions of a column to create in the table. Minimum one required, unless the table is created from clone_from or like, whose columns are read from the source table instead. Columns are added, dropped, renamed with previous_name and altered in place. Snowflake always adds columns at the end of the table and cannot reorder them, so new columns must be declared after the existing ones.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
@@ -54,6 +75,49 @@ var tableSchema = map[string]*schema.Schema{
 					Required:    true,
 					Description: "Column type, e.g. VARIANT",
 				},
+				"previous_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name the column had before, to rename it in place instead of dropping it and adding a new column.",
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether the column accepts NULL values.",
+				},
+				"default": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Expression used as the column's default value, e.g. CURRENT_TIMESTAMP() or 'text'. The default of an existing column can only be dropped or set to a sequence's NEXTVAL.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Column comment",
+				},
+				"identity": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Makes the column an identity (autoincrement) column. Cannot be combined with default, nor changed on an existing column.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"start_num": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     1,
+								Description: "The first value of the column.",
+							},
+							"step_num": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     1,
+								Description: "The amount added to the previous value for each row.",
+							},
+						},
+					},
+				},
 			},
 		},
 	},
@@ -62,25 +126,283 @@ var tableSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the table.",
 	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the table.",
+	},
+	"data_retention_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies the number of days for which Time Travel actions (CLONE and UNDROP) can be performed on the table. Defaults to the retention time of the schema.",
+		ValidateFunc: validation.IntBetween(0, 90),
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the table.",
+	},
+	"table_type": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "PERMANENT",
+		ForceNew:         true,
+		Description:      "Specifies the type of the table, PERMANENT or TRANSIENT. Transient tables don't have a Fail-safe period.",
+		ValidateFunc:     validation.StringInSlice([]string{"PERMANENT", "TRANSIENT"}, true),
+		DiffSuppressFunc: diffCaseInsensitive,
+	},
+	"clone_from": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"column", "like"},
+		Description:   "Creates the table as a zero-copy clone of a source table, optionally as it was at or before a point in time. The other attributes are applied to the clone afterwards, so the comment, clustering key, change tracking and constraints it copied are replaced or removed when unset.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Database of the source table.",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Schema of the source table.",
+				},
+				"table": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the source table.",
+				},
+				"at_or_before": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "AT",
+					Description:  "Whether to clone the source as it was AT or right BEFORE the point in time set by timestamp, offset or statement.",
+					ValidateFunc: validation.StringInSlice([]string{"AT", "BEFORE"}, false),
+				},
+				"timestamp": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Point in time to clone the source at, e.g. 2021-01-01 00:00:00 +0000.",
+				},
+				"offset": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Point in time to clone the source at, as a number of seconds from now, e.g. -3600.",
+				},
+				"statement": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "ID of the statement to clone the source at.",
+				},
+			},
+		},
+	},
+	"like": {
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"column", "clone_from"},
+		Description:   "Creates the table empty with the column definitions of a source table. The other attributes are applied to the table afterwards, so the properties it copied are replaced or removed when unset.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Database of the source table.",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Schema of the source table.",
+				},
+				"table": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the source table.",
+				},
+			},
+		},
+	},
+	"primary_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Declares the primary key of the table. Snowflake doesn't enforce it, but makes its columns NOT NULL, so they must set nullable = false.",
+		Elem:        &schema.Resource{Schema: tableKeySchema},
+	},
+	"unique_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Declares unique constraints on the table. Snowflake doesn't enforce them.",
+		Elem:        &schema.Resource{Schema: tableKeySchema},
+	},
+	"foreign_key": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Declares foreign keys of the table. Snowflake doesn't enforce them.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the constraint.",
+				},
+				"keys": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Columns of the foreign key.",
+				},
+				"references": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "The primary or unique key the foreign key references.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"database": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Database of the referenced table.",
+							},
+							"schema": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Schema of the referenced table.",
+							},
+							"table": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Name of the referenced table.",
+							},
+							"keys": {
+								Type:        schema.TypeList,
+								Required:    true,
+								MinItems:    1,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Referenced columns, in the order of keys.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	"owner": {
 		Type:        schema.TypeString,
 		Computed:    true,
 		Description: "Name of the role that owns the table.",
 	},
+	"deletion_protection": deletionProtectionSchema,
+	"recover_if_dropped":  recoverIfDroppedSchema,
+}
+
+// tableConstraints lists the constraint blocks of the table and their kind in
+// the order they are added; they are dropped in the reverse order so that
+// foreign keys go before the keys they reference.
+var tableConstraints = []struct {
+	key  string
+	kind string
+}{
+	{"primary_key", snowflake.PrimaryKeyConstraint},
+	{"unique_key", snowflake.UniqueKeyConstraint},
+	{"foreign_key", snowflake.ForeignKeyConstraint},
 }
 
 func Table() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTable,
-		Read:   ReadTable,
-		Update: UpdateTable,
-		Delete: DeleteTable,
+		CreateContext: CreateTable,
+		ReadContext:   ReadTable,
+		UpdateContext: UpdateTable,
+		DeleteContext: DeleteTable,
 
 		Schema: tableSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		CustomizeDiff: customizeTableDiff,
+	}
+}
+
+// customizeTableDiff rejects at plan time the columns that would never match
+// their definition once applied.
+func customizeTableDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	err := validatePrimaryKeyNullability(d)
+	if err != nil {
+		return err
+	}
+	return validateColumnOrder(d)
+}
+
+// validatePrimaryKeyNullability rejects nullable primary key columns, since
+// Snowflake makes them NOT NULL.
+func validatePrimaryKeyNullability(d *schema.ResourceDiff) error {
+	nullable := map[string]bool{}
+	for _, c := range d.Get("column").([]interface{}) {
+		column := c.(map[string]interface{})
+		nullable[column["name"].(string)] = column["nullable"].(bool)
+	}
+	for _, pk := range d.Get("primary_key").([]interface{}) {
+		if pk == nil {
+			continue
+		}
+		for _, key := range pk.(map[string]interface{})["keys"].([]interface{}) {
+			if nullable[key.(string)] {
+				return errors.Errorf("column %v is part of the primary key, so it must set nullable = false", key)
+			}
+		}
+	}
+	return nil
+}
+
+// validateColumnOrder rejects new columns declared before existing ones and
+// existing columns moved around, since Snowflake adds columns at the end of
+// the table and cannot reorder them.
+func validateColumnOrder(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("column") {
+		return nil
 	}
+	o, n := d.GetChange("column")
+	renamed := renamedColumns(columnsByName(o.([]interface{})), columnsByName(n.([]interface{})))
+
+	positions := map[string]int{}
+	for i, c := range o.([]interface{}) {
+		positions[c.(map[string]interface{})["name"].(string)] = i
+	}
+
+	last, added := -1, ""
+	for _, c := range n.([]interface{}) {
+		name := c.(map[string]interface{})["name"].(string)
+		oldName := name
+		if previous, ok := renamed[name]; ok {
+			oldName = previous
+		}
+
+		i, existed := positions[oldName]
+		if !existed {
+			if added == "" {
+				added = name
+			}
+			continue
+		}
+		if added != "" {
+			return errors.Errorf("column %v must be declared after the existing column %v, since Snowflake adds columns at the end of the table", added, name)
+		}
+		if i < last {
+			return errors.Errorf("column %v cannot be moved, since Snowflake cannot reorder columns", name)
+		}
+		last = i
+	}
+	return nil
 }
 
 type tableID struct {
@@ -129,34 +451,70 @@ func tableIDFromString(stringID string) (*tableID, error) {
 	return tableResult, nil
 }
 
-// CreateTable implements schema.CreateFunc
-func CreateTable(d *schema.ResourceData, meta interface{}) error {
+// CreateTable implements schema.CreateContextFunc
+func CreateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
 
+	if undrop(ctx, d, db, snowflake.Table(name, database, schema).Undrop()) {
+		return recoverTable(ctx, d, meta)
+	}
+
+	if _, ok := d.GetOk("clone_from"); ok {
+		return createTableFromSource(ctx, d, meta)
+	}
+	if _, ok := d.GetOk("like"); ok {
+		return createTableFromSource(ctx, d, meta)
+	}
+
 	// This type conversion is due to the test framework in the terraform-plugin-sdk having limited support
 	// for data types in the HCL2ValueFromConfigValue method.
 	columns := []snowflake.Column{}
 
 	for _, column := range d.Get("column").([]interface{}) {
-		typed := column.(map[string]interface{})
-		columnDef := snowflake.Column{}
-		columnDef.WithName(typed["name"].(string)).WithType(typed["type"].(string))
-		columns = append(columns, columnDef)
+		columns = append(columns, expandColumn(column.(map[string]interface{})))
+	}
+	if len(columns) == 0 {
+		return diag.Errorf("at least one column is required to create table %v unless clone_from or like is set", name)
 	}
 	builder := snowflake.TableWithColumnDefinitions(name, database, schema, columns)
 
 	// Set optionals
+	if strings.EqualFold(d.Get("table_type").(string), "TRANSIENT") {
+		builder.Transient()
+	}
+
 	if v, ok := d.GetOk("comment"); ok {
 		builder.WithComment(v.(string))
 	}
 
+	if v, ok := d.GetOk("cluster_by"); ok {
+		builder.WithClusterBy(expandStringList(v.([]interface{})))
+	}
+
+	// GetOkExists so that a retention of 0 days is set rather than inherited
+	if v, ok := d.GetOkExists("data_retention_days"); ok { // nolint: staticcheck
+		builder.WithDataRetentionDays(v.(int))
+	}
+
+	if v, ok := d.GetOk("change_tracking"); ok {
+		builder.WithChangeTracking(v.(bool))
+	}
+
+	constraints := []snowflake.TableConstraint{}
+	for _, c := range tableConstraints {
+		for _, constraint := range d.Get(c.key).([]interface{}) {
+			constraints = append(constraints, expandTableConstraint(c.kind, constraint.(map[string]interface{})))
+		}
+	}
+	builder.WithConstraints(constraints)
+
 	stmt := builder.Create()
-	err := snowflake.Exec(db, stmt)
+	err := snowflake.Exec(ctx, db, stmt)
 	if err != nil {
-		return errors.Wrapf(err, "error creating table %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating table %v", name))
 	}
 
 	tableID := &tableID{
@@ -166,23 +524,130 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := tableID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTable(d, meta)
+	return ReadTable(ctx, d, meta)
 }
 
-// ReadTable implements schema.ReadFunc
-func ReadTable(d *schema.ResourceData, meta interface{}) error {
+// createTableFromSource creates the table from the table of its clone_from or
+// like block and then alters the properties copied from the source to match
+// the configuration, including the unset ones.
+func createTableFromSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	db := meta.(*sql.DB)
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	name := d.Get("name").(string)
+
+	var builder *snowflake.TableCloneBuilder
+	if v, ok := d.GetOk("clone_from"); ok {
+		source := v.([]interface{})[0].(map[string]interface{})
+		table := snowflake.Table(source["table"].(string), source["database"].(string), source["schema"].(string))
+		builder = snowflake.TableFromTable(name, database, schema, table)
+
+		points := map[string]string{}
+		if t := source["timestamp"].(string); t != "" {
+			points[snowflake.TimeTravelTimestamp] = t
+		}
+		if o := source["offset"].(int); o != 0 {
+			points[snowflake.TimeTravelOffset] = strconv.Itoa(o)
+		}
+		if s := source["statement"].(string); s != "" {
+			points[snowflake.TimeTravelStatement] = s
+		}
+		if len(points) > 1 {
+			return diag.Errorf("only one of timestamp, offset and statement can be set to clone table %v", name)
+		}
+		for point, value := range points {
+			if source["at_or_before"].(string) == "BEFORE" {
+				builder.Before(point, value)
+			} else {
+				builder.At(point, value)
+			}
+		}
+	} else {
+		source := d.Get("like").([]interface{})[0].(map[string]interface{})
+		table := snowflake.Table(source["table"].(string), source["database"].(string), source["schema"].(string))
+		builder = snowflake.TableLikeTable(name, database, schema, table)
+	}
+
+	if strings.EqualFold(d.Get("table_type").(string), "TRANSIENT") {
+		builder.Transient()
+	}
+
+	err := snowflake.Exec(ctx, db, builder.Create())
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error creating table %v", name))
+	}
+
+	tableID := &tableID{
+		DatabaseName: database,
+		SchemaName:   schema,
+		TableName:    name,
+	}
+	dataIDInput, err := tableID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	actual, diags := readActualTable(ctx, dataIDInput, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return updateTable(ctx, d, recoveredChanges{schema: tableSchema, actual: actual, d: d}, meta)
+}
+
+// recoverTable sets the ID of the table just restored by UNDROP and alters it
+// to match the configuration, unless its table_type differs.
+func recoverTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableID := &tableID{
+		DatabaseName: d.Get("database").(string),
+		SchemaName:   d.Get("schema").(string),
+		TableName:    d.Get("name").(string),
+	}
+	dataIDInput, err := tableID.String()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	actual, diags := readActualTable(ctx, dataIDInput, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if err := checkRecovered(actual, d, "table_type"); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataIDInput)
+
+	return updateTable(ctx, d, recoveredChanges{schema: tableSchema, actual: actual, d: d}, meta)
+}
+
+// readActualTable reads the table of id, which must exist, into a new
+// ResourceData, e.g. to reconcile it with a configuration.
+func readActualTable(ctx context.Context, id string, meta interface{}) (*schema.ResourceData, diag.Diagnostics) {
+	actual := Table().Data(nil)
+	actual.SetId(id)
+	if diags := ReadTable(ctx, actual, meta); diags.HasError() {
+		return nil, diags
+	}
+	if actual.Id() == "" {
+		return nil, diag.Errorf("table %v not found", id)
+	}
+	return actual, nil
+}
+
+// ReadTable implements schema.ReadContextFunc
+func ReadTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	builder := snowflake.Table(tableID.TableName, tableID.DatabaseName, tableID.SchemaName)
 
-	row := snowflake.QueryRow(db, builder.Show())
+	row := snowflake.QueryRow(ctx, db, builder.Show())
 	table, err := snowflake.ScanTable(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -191,18 +656,62 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	retentionDays, err := strconv.Atoi(table.RetentionTime.String)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "error reading data retention days of table %v", d.Id()))
 	}
 
 	// Describe the table to read the cols
-	tableDescriptionRows, err := snowflake.Query(db, builder.ShowColumns())
+	tableDescriptionRows, err := snowflake.Query(ctx, db, builder.ShowColumns())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tableDescription, err := snowflake.ScanTableDescription(tableDescriptionRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	// Read the declared constraints
+	keys := map[string]snowflake.TableConstraints{}
+	for _, c := range tableConstraints {
+		var q string
+		switch c.kind {
+		case snowflake.PrimaryKeyConstraint:
+			q = builder.ShowPrimaryKeys()
+		case snowflake.UniqueKeyConstraint:
+			q = builder.ShowUniqueKeys()
+		case snowflake.ForeignKeyConstraint:
+			q = builder.ShowImportedKeys()
+		}
+		rows, err := snowflake.Query(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error reading constraints of table %v", d.Id()))
+		}
+		if c.kind == snowflake.ForeignKeyConstraint {
+			keys[c.key], err = snowflake.ScanImportedKeys(rows)
+		} else {
+			keys[c.key], err = snowflake.ScanTableKeys(rows, c.kind)
+		}
+		rows.Close()
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error reading constraints of table %v", d.Id()))
+		}
+	}
+
+	// previous_name only exists in the configuration, so it is kept as is
+	columns := snowflake.NewColumns(tableDescription).Flatten()
+	previousNames := map[string]string{}
+	for _, c := range d.Get("column").([]interface{}) {
+		column := c.(map[string]interface{})
+		previousNames[column["name"].(string)] = column["previous_name"].(string)
+	}
+	for _, c := range columns {
+		column := c.(map[string]interface{})
+		column["previous_name"] = previousNames[column["name"].(string)]
 	}
 
 	// Set the relevant data in the state
@@ -212,23 +721,45 @@ func ReadTable(d *schema.ResourceData, meta interface{}) error {
 		"database": tableID.DatabaseName,
 		"schema":   tableID.SchemaName,
 		"comment":  table.Comment.String,
-		"column":   snowflake.NewColumns(tableDescription).Flatten(),
+		"column":   columns,
+
+		"cluster_by":          table.GetClusterBy(),
+		"data_retention_days": retentionDays,
+		"change_tracking":     table.ChangeTracking.String == "ON",
+		"table_type":          tableType(table.Kind.String),
+
+		"primary_key": keys["primary_key"].Flatten(),
+		"unique_key":  orderTableConstraints(keys["unique_key"].Flatten(), d.Get("unique_key").([]interface{})),
+		"foreign_key": orderTableConstraints(keys["foreign_key"].Flatten(), d.Get("foreign_key").([]interface{})),
 	}
 
 	for key, val := range toSet {
 		err = d.Set(key, val) //lintignore:R001
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-// UpdateTable implements schema.UpdateFunc
-func UpdateTable(d *schema.ResourceData, meta interface{}) error {
+// tableType returns the table_type of a table of the given SHOW TABLES kind
+func tableType(kind string) string {
+	if kind == "TRANSIENT" {
+		return "TRANSIENT"
+	}
+	return "PERMANENT"
+}
+
+// UpdateTable implements schema.UpdateContextFunc
+func UpdateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return updateTable(ctx, d, d, meta)
+}
+
+// updateTable alters the table of d for the given attribute changes
+func updateTable(ctx context.Context, d *schema.ResourceData, changes attributeChanges, meta interface{}) diag.Diagnostics {
 	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := tableID.DatabaseName
@@ -238,64 +769,303 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 	builder := snowflake.Table(tableName, dbName, schema)
 
 	db := meta.(*sql.DB)
-	if d.HasChange("comment") {
+
+	// the ID holds the current name of the table, which is already the
+	// configured one when it was just created by createTableFromSource
+	if name := d.Get("name").(string); name != tableName {
+		q := builder.Rename(name)
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error renaming table %v to %v", d.Id(), name))
+		}
+
+		tableID.TableName = name
+		dataIDInput, err := tableID.String()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(dataIDInput)
+		builder = snowflake.Table(name, dbName, schema)
+	}
+
+	// constraints are dropped before the columns change, since they may use
+	// dropped columns, and added afterwards, since they may use added ones
+	constraintDrops, constraintAdds := []string{}, []string{}
+	for i := len(tableConstraints) - 1; i >= 0; i-- {
+		c := tableConstraints[i]
+		if changes.HasChange(c.key) {
+			o, n := changes.GetChange(c.key)
+			drops, adds := changeTableConstraints(builder, c.kind, o.([]interface{}), n.([]interface{}))
+			constraintDrops = append(constraintDrops, drops...)
+			constraintAdds = append(adds, constraintAdds...)
+		}
+	}
+	for _, q := range constraintDrops {
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error dropping table constraint on %v", d.Id()))
+		}
+	}
+
+	if changes.HasChange("column") {
+		o, n := changes.GetChange("column")
+		stmts, err := changeColumns(builder, o.([]interface{}), n.([]interface{}))
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating table columns on %v", d.Id()))
+		}
+		for _, q := range stmts {
+			err := snowflake.Exec(ctx, db, q)
+			if err != nil {
+				return diag.FromErr(errors.Wrapf(err, "error updating table columns on %v", d.Id()))
+			}
+		}
+	}
+
+	for _, q := range constraintAdds {
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error adding table constraint on %v", d.Id()))
+		}
+	}
+
+	if changes.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating table comment on %v", d.Id()))
+		}
+	}
+
+	if changes.HasChange("cluster_by") {
+		clusterBy := expandStringList(d.Get("cluster_by").([]interface{}))
+		q := builder.ChangeClusterBy(clusterBy)
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating table clustering key on %v", d.Id()))
+		}
+	}
+
+	if changes.HasChange("data_retention_days") {
+		days := d.Get("data_retention_days")
+		q := builder.ChangeDataRetentionDays(days.(int))
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating table comment on %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating data retention days on %v", d.Id()))
 		}
 	}
 
-	return ReadTable(d, meta)
+	if changes.HasChange("change_tracking") {
+		changeTracking := d.Get("change_tracking")
+		q := builder.ChangeChangeTracking(changeTracking.(bool))
+		err := snowflake.Exec(ctx, db, q)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "error updating change tracking on %v", d.Id()))
+		}
+	}
+
+	return ReadTable(ctx, d, meta)
 }
 
-// DeleteTable implements schema.DeleteFunc
-func DeleteTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	tableID, err := tableIDFromString(d.Id())
-	if err != nil {
-		return err
+// expandColumn turns a column block into a column definition
+func expandColumn(column map[string]interface{}) snowflake.Column {
+	c := snowflake.Column{}
+	c.WithName(column["name"].(string)).
+		WithType(column["type"].(string)).
+		WithNullable(column["nullable"].(bool)).
+		WithDefault(column["default"].(string)).
+		WithComment(column["comment"].(string))
+	if identity := column["identity"].([]interface{}); len(identity) > 0 && identity[0] != nil {
+		i := identity[0].(map[string]interface{})
+		c.WithIdentity(i["start_num"].(int), i["step_num"].(int))
 	}
+	return c
+}
 
-	dbName := tableID.DatabaseName
-	schema := tableID.SchemaName
-	tableName := tableID.TableName
+// changeColumns returns the statements turning the old column blocks into the
+// new ones. Columns are matched by name, or by previous_name for renamed ones.
+// Drops run first so that renamed and added columns can reuse their names,
+// then renames, alterations of the remaining columns, and additions in the
+// order of the new list.
+func changeColumns(builder *snowflake.TableBuilder, o, n []interface{}) ([]string, error) {
+	oldColumns, newColumns := columnsByName(o), columnsByName(n)
 
-	q := snowflake.Table(tableName, dbName, schema).Drop()
+	renamed := renamedColumns(oldColumns, newColumns)
+	renamedFrom := map[string]bool{}
+	for newName, oldName := range renamed {
+		if renamedFrom[oldName] {
+			return nil, errors.Errorf("column %v is renamed to several columns, including %v", oldName, newName)
+		}
+		renamedFrom[oldName] = true
+	}
 
-	err = snowflake.Exec(db, q)
-	if err != nil {
-		return errors.Wrapf(err, "error deleting pipe %v", d.Id())
+	drops := []string{}
+	for _, c := range o {
+		name := c.(map[string]interface{})["name"].(string)
+		if _, ok := newColumns[name]; !ok && !renamedFrom[name] {
+			drops = append(drops, builder.DropColumn(name))
+		}
 	}
 
-	d.SetId("")
+	renames, alters, adds := []string{}, []string{}, []string{}
+	for _, c := range n {
+		column := c.(map[string]interface{})
+		name := column["name"].(string)
 
-	return nil
+		previous, existed := oldColumns[name]
+		if oldName, ok := renamed[name]; ok {
+			renames = append(renames, builder.RenameColumn(oldName, name))
+			previous = oldColumns[oldName]
+		} else if !existed {
+			adds = append(adds, builder.AddColumn(expandColumn(column)))
+			continue
+		}
+
+		if !reflect.DeepEqual(previous["identity"], column["identity"]) {
+			return nil, errors.Errorf("the identity of column %v cannot be changed", name)
+		}
+		if previous["type"] != column["type"] {
+			alters = append(alters, builder.ChangeColumnType(name, column["type"].(string)))
+		}
+		if previous["nullable"] != column["nullable"] {
+			alters = append(alters, builder.ChangeColumnNullable(name, column["nullable"].(bool)))
+		}
+		if previous["default"] != column["default"] {
+			alters = append(alters, builder.ChangeColumnDefault(name, column["default"].(string)))
+		}
+		if previous["comment"] != column["comment"] {
+			alters = append(alters, builder.ChangeColumnComment(name, column["comment"].(string)))
+		}
+	}
+
+	stmts := append(drops, renames...)
+	stmts = append(stmts, alters...)
+	return append(stmts, adds...), nil
 }
 
-// TableExists implements schema.ExistsFunc
-func TableExists(data *schema.ResourceData, meta interface{}) (bool, error) {
+// orderTableConstraints sorts the constraint blocks read from Snowflake like
+// the blocks of current, since Snowflake doesn't keep their order and the plan
+// would otherwise never settle. Constraints missing from current come last.
+func orderTableConstraints(read, current []interface{}) []interface{} {
+	position := map[string]int{}
+	for i, c := range current {
+		position[c.(map[string]interface{})["name"].(string)] = i
+	}
+	rank := func(c interface{}) int {
+		if i, ok := position[c.(map[string]interface{})["name"].(string)]; ok {
+			return i
+		}
+		return len(current)
+	}
+	sort.SliceStable(read, func(i, j int) bool { return rank(read[i]) < rank(read[j]) })
+	return read
+}
+
+// columnsByName indexes column blocks by name
+func columnsByName(columns []interface{}) map[string]map[string]interface{} {
+	byName := map[string]map[string]interface{}{}
+	for _, c := range columns {
+		column := c.(map[string]interface{})
+		byName[column["name"].(string)] = column
+	}
+	return byName
+}
+
+// renamedColumns maps the name of each new column renamed from an old one with
+// previous_name to that old name. A previous_name is ignored when the column
+// already exists or the old column is gone or still used, e.g. once the rename
+// has been applied.
+func renamedColumns(oldColumns, newColumns map[string]map[string]interface{}) map[string]string {
+	renamed := map[string]string{}
+	for name, column := range newColumns {
+		previous, _ := column["previous_name"].(string)
+		if previous == "" {
+			continue
+		}
+		if _, existed := oldColumns[name]; existed {
+			continue
+		}
+		if _, ok := oldColumns[previous]; !ok {
+			continue
+		}
+		if _, kept := newColumns[previous]; kept {
+			continue
+		}
+		renamed[name] = previous
+	}
+	return renamed
+}
+
+// expandTableConstraint turns a primary_key, unique_key or foreign_key block
+// into a constraint of the given kind
+func expandTableConstraint(kind string, constraint map[string]interface{}) snowflake.TableConstraint {
+	name := constraint["name"].(string)
+	keys := expandStringList(constraint["keys"].([]interface{}))
+
+	switch kind {
+	case snowflake.PrimaryKeyConstraint:
+		return snowflake.PrimaryKey(name, keys)
+	case snowflake.UniqueKeyConstraint:
+		return snowflake.UniqueKey(name, keys)
+	}
+
+	references := constraint["references"].([]interface{})[0].(map[string]interface{})
+	table := snowflake.Table(references["table"].(string), references["database"].(string), references["schema"].(string))
+	return snowflake.ForeignKey(name, keys, table, expandStringList(references["keys"].([]interface{})))
+}
+
+// changeTableConstraints returns the statements dropping the old constraints
+// of the given kind that were removed or changed and adding the new or changed
+// ones. Constraints are matched by name.
+func changeTableConstraints(builder *snowflake.TableBuilder, kind string, o, n []interface{}) (drops []string, adds []string) {
+	oldConstraints := map[string]interface{}{}
+	for _, c := range o {
+		oldConstraints[c.(map[string]interface{})["name"].(string)] = c
+	}
+	newConstraints := map[string]interface{}{}
+	for _, c := range n {
+		newConstraints[c.(map[string]interface{})["name"].(string)] = c
+	}
+
+	for _, c := range o {
+		name := c.(map[string]interface{})["name"].(string)
+		if !reflect.DeepEqual(c, newConstraints[name]) {
+			drops = append(drops, builder.DropConstraint(name))
+		}
+	}
+	for _, c := range n {
+		constraint := c.(map[string]interface{})
+		if !reflect.DeepEqual(c, oldConstraints[constraint["name"].(string)]) {
+			adds = append(adds, builder.AddConstraint(expandTableConstraint(kind, constraint)))
+		}
+	}
+	return drops, adds
+}
+
+// DeleteTable implements schema.DeleteContextFunc
+func DeleteTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
-	tableID, err := tableIDFromString(data.Id())
+	if err := checkDeletionProtection(d, "table"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
-		return false, err
+		return diag.FromErr(err)
 	}
 
 	dbName := tableID.DatabaseName
 	schema := tableID.SchemaName
 	tableName := tableID.TableName
 
-	q := snowflake.Table(tableName, dbName, schema).Show()
-	rows, err := db.Query(q)
+	q := snowflake.Table(tableName, dbName, schema).Drop()
+
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return false, err
+		return diag.FromErr(errors.Wrapf(err, "error deleting pipe %v", d.Id()))
 	}
-	defer rows.Close()
 
-	if rows.Next() {
-		return true, nil
-	}
+	d.SetId("")
 
-	return false, nil
+	return nil
 }
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -79,9 +82,9 @@ var tableGrantSchema = map[string]*schema.Schema{
 func TableGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateTableGrant,
-			Read:   ReadTableGrant,
-			Delete: DeleteTableGrant,
+			CreateContext: CreateTableGrant,
+			ReadContext:   ReadTableGrant,
+			DeleteContext: DeleteTableGrant,
 
 			Schema: tableGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -92,8 +95,8 @@ func TableGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateTableGrant implements schema.CreateFunc
-func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateTableGrant implements schema.CreateContextFunc
+func CreateTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		tableName  string
 		schemaName string
@@ -114,11 +117,11 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (schemaName == "") && !onFuture {
-		return errors.New("schema_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true."))
 	}
 
 	if (tableName == "") && !onFuture {
-		return errors.New("table_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("table_name must be set unless on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -128,9 +131,9 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TableGrant(dbName, schemaName, tableName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// table_name is empty when on_future = true
@@ -146,17 +149,17 @@ func CreateTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	dataIDInput, err := grantID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
-	return ReadTableGrant(d, meta)
+	return ReadTableGrant(ctx, d, meta)
 }
 
-// ReadTableGrant implements schema.ReadFunc
-func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadTableGrant implements schema.ReadContextFunc
+func ReadTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dbName := grantID.ResourceName
@@ -166,11 +169,11 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	onFuture := false
 	if tableName == "" {
@@ -178,19 +181,19 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("table_name", tableName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", onFuture)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -200,14 +203,17 @@ func ReadTableGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.TableGrant(dbName, schemaName, tableName)
 	}
 
-	return readGenericGrant(d, meta, tableGrantSchema, builder, onFuture, validTablePrivileges)
+	if err := readGenericGrant(ctx, d, meta, tableGrantSchema, builder, onFuture, validTablePrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteTableGrant implements schema.DeleteFunc
-func DeleteTableGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteTableGrant implements schema.DeleteContextFunc
+func DeleteTableGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tableName := grantID.ObjectName
@@ -224,5 +230,8 @@ func DeleteTableGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.TableGrant(dbName, schemaName, tableName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
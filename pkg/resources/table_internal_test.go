@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -70,3 +71,60 @@ func TestTableStruct(t *testing.T) {
 	r.Equal("database|name", newTable.DatabaseName)
 	r.Equal("table|name", newTable.TableName)
 }
+
+func TestChangeColumns(t *testing.T) {
+	r := require.New(t)
+	builder := snowflake.Table("table", "database_name", "schema_name")
+	column := func(name, typ string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "type": typ, "nullable": true, "default": "", "comment": "", "identity": []interface{}{}}
+	}
+
+	// legacy is dropped and created_at added since no column takes its place
+	notNull := column("id", "NUMBER(38,0)")
+	notNull["nullable"] = false
+	stmts, err := changeColumns(builder,
+		[]interface{}{column("legacy", "VARCHAR"), column("id", "NUMBER(38,0)")},
+		[]interface{}{notNull, column("created_at", "TIMESTAMP_NTZ")},
+	)
+	r.NoError(err)
+	r.Equal([]string{
+		`ALTER TABLE "database_name"."schema_name"."table" DROP COLUMN "legacy"`,
+		`ALTER TABLE "database_name"."schema_name"."table" ALTER COLUMN "id" SET NOT NULL`,
+		`ALTER TABLE "database_name"."schema_name"."table" ADD COLUMN "created_at" TIMESTAMP_NTZ`,
+	}, stmts)
+
+	// data is renamed to payload since it is its previous_name
+	payload := column("payload", "VARIANT")
+	payload["previous_name"] = "data"
+	stmts, err = changeColumns(builder,
+		[]interface{}{column("id", "NUMBER(38,0)"), column("data", "VARCHAR")},
+		[]interface{}{column("id", "NUMBER(38,0)"), payload},
+	)
+	r.NoError(err)
+	r.Equal([]string{
+		`ALTER TABLE "database_name"."schema_name"."table" RENAME COLUMN "data" TO "payload"`,
+		`ALTER TABLE "database_name"."schema_name"."table" ALTER COLUMN "payload" SET DATA TYPE VARIANT`,
+	}, stmts)
+
+	// without previous_name, a column replaced at the same position is dropped
+	stmts, err = changeColumns(builder,
+		[]interface{}{column("id", "NUMBER(38,0)"), column("data", "VARCHAR")},
+		[]interface{}{column("id", "NUMBER(38,0)"), column("payload", "VARIANT")},
+	)
+	r.NoError(err)
+	r.Equal([]string{
+		`ALTER TABLE "database_name"."schema_name"."table" DROP COLUMN "data"`,
+		`ALTER TABLE "database_name"."schema_name"."table" ADD COLUMN "payload" VARIANT`,
+	}, stmts)
+
+	// previous_name is ignored once the rename has been applied
+	stmts, err = changeColumns(builder, []interface{}{payload}, []interface{}{payload})
+	r.NoError(err)
+	r.Empty(stmts)
+
+	// the identity of an existing column can't be altered
+	identity := column("id", "NUMBER(38,0)")
+	identity["identity"] = []interface{}{map[string]interface{}{"start_num": 1, "step_num": 1}}
+	_, err = changeColumns(builder, []interface{}{column("id", "NUMBER(38,0)")}, []interface{}{identity})
+	r.EqualError(err, "the identity of column id cannot be changed")
+}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/resources"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,21 +29,221 @@ func TestTableCreate(t *testing.T) {
 		"database": "database_name",
 		"schema":   "schema_name",
 		"comment":  "great comment",
-		"column":   []interface{}{map[string]interface{}{"name": "column1", "type": "OBJECT"}, map[string]interface{}{"name": "column2", "type": "VARCHAR"}},
+		"column":   []interface{}{map[string]interface{}{"name": "column1", "type": "OBJECT", "nullable": false}, map[string]interface{}{"name": "column2", "type": "VARCHAR"}},
+
+		"cluster_by":          []interface{}{"column1"},
+		"data_retention_days": 7,
+		"change_tracking":     true,
+
+		"primary_key": []interface{}{map[string]interface{}{"name": "pk", "keys": []interface{}{"column1"}}},
+		"foreign_key": []interface{}{map[string]interface{}{
+			"name":       "fk_parent",
+			"keys":       []interface{}{"column2"},
+			"references": []interface{}{map[string]interface{}{"database": "database_name", "schema": "schema_name", "table": "parent", "keys": []interface{}{"id"}}},
+		}},
 	}
 	d := table(t, "database_name|schema_name|good_name", in)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		mock.ExpectExec(`CREATE TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT, "column2" VARCHAR\) COMMENT = 'great comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`CREATE TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT NOT NULL, "column2" VARCHAR, CONSTRAINT "pk" PRIMARY KEY \("column1"\), CONSTRAINT "fk_parent" FOREIGN KEY \("column2"\) REFERENCES "database_name"."schema_name"."parent" \("id"\)\) CLUSTER BY \(column1\) DATA_RETENTION_TIME_IN_DAYS = 7 CHANGE_TRACKING = TRUE COMMENT = 'great comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectTableRead(mock)
-		err := resources.CreateTable(d, db)
-		r.NoError(err)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 	})
 }
 
+func TestTableCreateTransient(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":       "good_name",
+		"database":   "database_name",
+		"schema":     "schema_name",
+		"table_type": "transient",
+		"column":     []interface{}{map[string]interface{}{"name": "column1", "type": "OBJECT"}},
+	}
+	d := table(t, "database_name|schema_name|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE TRANSIENT TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestTableCreateWithoutRetention(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                "good_name",
+		"database":            "database_name",
+		"schema":              "schema_name",
+		"column":              []interface{}{map[string]interface{}{"name": "column1", "type": "OBJECT"}},
+		"data_retention_days": 0,
+	}
+	d := table(t, "database_name|schema_name|good_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT\) DATA_RETENTION_TIME_IN_DAYS = 0$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestTableCreateFromClone(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"comment":  "great comment",
+		"clone_from": []interface{}{map[string]interface{}{
+			"database":     "database_name",
+			"schema":       "prod",
+			"table":        "source",
+			"at_or_before": "BEFORE",
+			"offset":       -3600,
+		}},
+	}
+	d := table(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE TABLE "database_name"."schema_name"."good_name" CLONE "database_name"."prod"."source" BEFORE\(OFFSET => -3600\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		expectResetClonedTable(mock, "great comment")
+		expectTableRead(mock)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("database_name|schema_name|good_name", d.Id())
+		r.Equal(2, d.Get("column.#"))
+	})
+}
+
+func TestTableCreateFromCloneWithoutComment(t *testing.T) {
+	r := require.New(t)
+
+	// the source table has a comment, which the clone must not keep
+	in := map[string]interface{}{
+		"name":       "good_name",
+		"database":   "database_name",
+		"schema":     "schema_name",
+		"clone_from": []interface{}{map[string]interface{}{"database": "database_name", "schema": "prod", "table": "source"}},
+	}
+	d := table(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE TABLE "database_name"."schema_name"."good_name" CLONE "database_name"."prod"."source"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		expectResetClonedTable(mock, "")
+		expectTableRead(mock)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+// expectResetClonedTable expects the properties the table of expectTableRead
+// copied from its source to be replaced by the configured comment and unset
+// other properties.
+func expectResetClonedTable(mock sqlmock.Sqlmock, comment string) {
+	mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" DROP CONSTRAINT "fk_parent"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" DROP CONSTRAINT "pk"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" SET COMMENT = '` + comment + `'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" DROP CLUSTERING KEY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" SET CHANGE_TRACKING = FALSE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+func TestTableCreateLike(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"like":     []interface{}{map[string]interface{}{"database": "database_name", "schema": "prod", "table": "source"}},
+	}
+	d := table(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE TABLE "database_name"."schema_name"."good_name" LIKE "database_name"."prod"."source"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		expectResetClonedTable(mock, "")
+		expectTableRead(mock)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestTableCreateRecover(t *testing.T) {
+	r := require.New(t)
+
+	// the restored table has a foreign key and change tracking on
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"comment":  "mock comment",
+		"column": []interface{}{
+			map[string]interface{}{"name": "column1", "type": "OBJECT"},
+			map[string]interface{}{"name": "column2", "type": "VARCHAR"},
+			map[string]interface{}{"name": "column3", "type": "NUMBER(38,0)"},
+		},
+
+		"cluster_by":      []interface{}{"column1", "SUBSTRING(column2, 1, 2)"},
+		"change_tracking": false,
+
+		"primary_key":        []interface{}{map[string]interface{}{"name": "pk", "keys": []interface{}{"column1"}}},
+		"recover_if_dropped": true,
+	}
+	d := table(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^UNDROP TABLE "database_name"."schema_name"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" DROP CONSTRAINT "fk_parent"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" ADD COLUMN "column3" NUMBER\(38,0\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" SET CHANGE_TRACKING = FALSE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("database_name|schema_name|good_name", d.Id())
+	})
+}
+
+func TestTableCreateRecoverOtherType(t *testing.T) {
+	r := require.New(t)
+
+	// the restored table is permanent, so it would be replaced right away
+	in := map[string]interface{}{
+		"name":               "good_name",
+		"database":           "database_name",
+		"schema":             "schema_name",
+		"table_type":         "transient",
+		"column":             []interface{}{map[string]interface{}{"name": "column1", "type": "OBJECT"}},
+		"recover_if_dropped": true,
+	}
+	d := table(t, "", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^UNDROP TABLE "database_name"."schema_name"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.CreateTable(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, "the restored good_name has table_type = PERMANENT but the configuration sets transient")
+		r.Empty(d.Id())
+	})
+}
+
 func expectTableRead(mock sqlmock.Sqlmock) {
-	rows := sqlmock.NewRows([]string{"name", "type", "kind", "null?", "default", "primary key", "unique key", "check", "expression", "comment"}).AddRow("good_name", "VARCHAR()", "COLUMN", "Y", "NULL", "NULL", "N", "N", "NULL", "mock comment")
+	expectTableReadWithUniqueKeys(mock, sqlmock.NewRows([]string{"created_on", "database_name", "schema_name", "table_name", "column_name", "key_sequence", "constraint_name", "rely", "comment"}))
+}
+
+func expectTableReadWithUniqueKeys(mock sqlmock.Sqlmock, uniqueKeys *sqlmock.Rows) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "kind", "comment", "cluster_by", "rows", "bytes", "owner", "retention_time", "automatic_clustering", "change_tracking"}).
+		AddRow("2021-01-01 00:00:00", "good_name", "database_name", "schema_name", "TABLE", "mock comment", "LINEAR(column1, SUBSTRING(column2, 1, 2))", "0", "0", "SYSADMIN", "7", "ON", "ON")
 	mock.ExpectQuery(`SHOW TABLES LIKE 'good_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
 
 	describeRows := sqlmock.NewRows([]string{"name", "type", "kind"}).
@@ -48,6 +251,16 @@ func expectTableRead(mock sqlmock.Sqlmock) {
 		AddRow("column2", "VARCHAR", "COLUMN")
 
 	mock.ExpectQuery(`DESC TABLE "database_name"."schema_name"."good_name"`).WillReturnRows(describeRows)
+
+	primaryKeys := sqlmock.NewRows([]string{"created_on", "database_name", "schema_name", "table_name", "column_name", "key_sequence", "constraint_name", "rely", "comment"}).
+		AddRow("2021-01-01 00:00:00", "database_name", "schema_name", "good_name", "column1", 1, "pk", "false", nil)
+	mock.ExpectQuery(`^SHOW PRIMARY KEYS IN TABLE "database_name"."schema_name"."good_name"$`).WillReturnRows(primaryKeys)
+
+	mock.ExpectQuery(`^SHOW UNIQUE KEYS IN TABLE "database_name"."schema_name"."good_name"$`).WillReturnRows(uniqueKeys)
+
+	importedKeys := sqlmock.NewRows([]string{"created_on", "pk_database_name", "pk_schema_name", "pk_table_name", "pk_column_name", "fk_database_name", "fk_schema_name", "fk_table_name", "fk_column_name", "key_sequence", "update_rule", "delete_rule", "fk_name", "pk_name", "deferrability", "rely", "comment"}).
+		AddRow("2021-01-01 00:00:00", "database_name", "schema_name", "parent", "id", "database_name", "schema_name", "good_name", "column2", 1, "NO ACTION", "NO ACTION", "fk_parent", "parent_pk", "NOT DEFERRABLE", "false", nil)
+	mock.ExpectQuery(`^SHOW IMPORTED KEYS IN TABLE "database_name"."schema_name"."good_name"$`).WillReturnRows(importedKeys)
 }
 
 func TestTableRead(t *testing.T) {
@@ -58,18 +271,329 @@ func TestTableRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectTableRead(mock)
 
-		err := resources.ReadTable(d, db)
-		r.NoError(err)
+		diags := resources.ReadTable(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
+		r.Equal([]interface{}{"column1", "SUBSTRING(column2, 1, 2)"}, d.Get("cluster_by"))
+		r.Equal(7, d.Get("data_retention_days"))
+		r.Equal(true, d.Get("change_tracking"))
+		r.Equal("PERMANENT", d.Get("table_type"))
+		r.Equal("pk", d.Get("primary_key.0.name"))
+		r.Equal([]interface{}{"column1"}, d.Get("primary_key.0.keys"))
+		r.Equal(0, d.Get("unique_key.#"))
+		r.Equal("fk_parent", d.Get("foreign_key.0.name"))
+		r.Equal([]interface{}{"column2"}, d.Get("foreign_key.0.keys"))
+		r.Equal("parent", d.Get("foreign_key.0.references.0.table"))
+		r.Equal([]interface{}{"id"}, d.Get("foreign_key.0.references.0.keys"))
 
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
 		q := snowflake.Table("good_name", "database_name", "schema_name").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadTable(d, db)
+		diags2 := resources.ReadTable(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
+	})
+}
+
+func TestTableReadUniqueKeysOrder(t *testing.T) {
+	r := require.New(t)
+
+	d := table(t, "database_name|schema_name|good_name", map[string]interface{}{
+		"name": "good_name",
+		"unique_key": []interface{}{
+			map[string]interface{}{"name": "uk_column2", "keys": []interface{}{"column2"}},
+			map[string]interface{}{"name": "uk_column1", "keys": []interface{}{"column1"}},
+		},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// Snowflake lists the keys in another order than the configuration
+		uniqueKeys := sqlmock.NewRows([]string{"created_on", "database_name", "schema_name", "table_name", "column_name", "key_sequence", "constraint_name", "rely", "comment"}).
+			AddRow("2021-01-01 00:00:00", "database_name", "schema_name", "good_name", "column1", 1, "uk_column1", "false", nil).
+			AddRow("2021-01-01 00:00:00", "database_name", "schema_name", "good_name", "column2", 1, "uk_column2", "false", nil)
+		expectTableReadWithUniqueKeys(mock, uniqueKeys)
+
+		diags := resources.ReadTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal(2, d.Get("unique_key.#"))
+		r.Equal("uk_column2", d.Get("unique_key.0.name"))
+		r.Equal([]interface{}{"column2"}, d.Get("unique_key.0.keys"))
+		r.Equal("uk_column1", d.Get("unique_key.1.name"))
+		r.Equal([]interface{}{"column1"}, d.Get("unique_key.1.keys"))
+	})
+}
+
+func TestTableUpdateColumns(t *testing.T) {
+	r := require.New(t)
+
+	// column2 gets a comment and column3 is added
+	res := resources.Table()
+	state := &terraform.InstanceState{
+		ID: "database_name|schema_name|good_name",
+		Attributes: map[string]string{
+			"name":              "good_name",
+			"database":          "database_name",
+			"schema":            "schema_name",
+			"table_type":        "PERMANENT",
+			"column.#":          "2",
+			"column.0.name":     "column1",
+			"column.0.type":     "OBJECT",
+			"column.0.nullable": "true",
+			"column.1.name":     "column2",
+			"column.1.type":     "VARCHAR",
+			"column.1.nullable": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"column": []interface{}{
+			map[string]interface{}{"name": "column1", "type": "OBJECT"},
+			map[string]interface{}{"name": "column2", "type": "VARCHAR", "comment": "second"},
+			map[string]interface{}{"name": "column3", "type": "NUMBER", "nullable": false, "default": "0"},
+		},
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" ALTER COLUMN "column2" COMMENT 'second'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" ADD COLUMN "column3" NUMBER DEFAULT 0 NOT NULL$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.UpdateTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestTableUpdateRenameColumn(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.Table()
+	state := &terraform.InstanceState{
+		ID: "database_name|schema_name|good_name",
+		Attributes: map[string]string{
+			"name":              "good_name",
+			"database":          "database_name",
+			"schema":            "schema_name",
+			"table_type":        "PERMANENT",
+			"column.#":          "2",
+			"column.0.name":     "column1",
+			"column.0.type":     "OBJECT",
+			"column.0.nullable": "true",
+			"column.1.name":     "data",
+			"column.1.type":     "VARCHAR",
+			"column.1.nullable": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"column": []interface{}{
+			map[string]interface{}{"name": "column1", "type": "OBJECT"},
+			map[string]interface{}{"name": "column2", "type": "VARCHAR", "previous_name": "data"},
+		},
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" RENAME COLUMN "data" TO "column2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.UpdateTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("data", d.Get("column.1.previous_name"))
+	})
+}
+
+func TestTableColumnOrder(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.Table()
+	state := &terraform.InstanceState{
+		ID: "database_name|schema_name|good_name",
+		Attributes: map[string]string{
+			"name":              "good_name",
+			"database":          "database_name",
+			"schema":            "schema_name",
+			"table_type":        "PERMANENT",
+			"column.#":          "2",
+			"column.0.name":     "column1",
+			"column.0.type":     "OBJECT",
+			"column.0.nullable": "true",
+			"column.1.name":     "column2",
+			"column.1.type":     "VARCHAR",
+			"column.1.nullable": "true",
+		},
+	}
+	column := func(name string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "type": "VARCHAR"}
+	}
+	diff := func(columns ...interface{}) error {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":     "good_name",
+			"database": "database_name",
+			"schema":   "schema_name",
+			"column":   columns,
+		})
+		_, err := res.Diff(context.Background(), state, config, nil)
+		return err
+	}
+
+	r.NoError(diff(column("column1"), column("column2"), column("column3")))
+	r.NoError(diff(column("column2")))
+	r.EqualError(diff(column("column1"), column("column3"), column("column2")),
+		"column column3 must be declared after the existing column column2, since Snowflake adds columns at the end of the table")
+	r.EqualError(diff(column("column2"), column("column1")),
+		"column column1 cannot be moved, since Snowflake cannot reorder columns")
+}
+
+func TestTableUpdateProperties(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.Table()
+	state := &terraform.InstanceState{
+		ID: "database_name|schema_name|good_name",
+		Attributes: map[string]string{
+			"name":                "good_name",
+			"database":            "database_name",
+			"schema":              "schema_name",
+			"table_type":          "PERMANENT",
+			"column.#":            "1",
+			"column.0.name":       "column1",
+			"column.0.type":       "OBJECT",
+			"column.0.nullable":   "true",
+			"cluster_by.#":        "1",
+			"cluster_by.0":        "column1",
+			"data_retention_days": "1",
+			"change_tracking":     "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "good_name",
+		"database":            "database_name",
+		"schema":              "schema_name",
+		"column":              []interface{}{map[string]interface{}{"name": "column1", "type": "OBJECT"}},
+		"data_retention_days": 7,
+		"change_tracking":     true,
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" DROP CLUSTERING KEY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" SET DATA_RETENTION_TIME_IN_DAYS = 7$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" SET CHANGE_TRACKING = TRUE$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.UpdateTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestTableUpdateConstraints(t *testing.T) {
+	r := require.New(t)
+
+	// the primary key gets a second column and uk is replaced by a foreign key
+	res := resources.Table()
+	state := &terraform.InstanceState{
+		ID: "database_name|schema_name|good_name",
+		Attributes: map[string]string{
+			"name":                 "good_name",
+			"database":             "database_name",
+			"schema":               "schema_name",
+			"table_type":           "PERMANENT",
+			"column.#":             "2",
+			"column.0.name":        "column1",
+			"column.0.type":        "OBJECT",
+			"column.0.nullable":    "false",
+			"column.1.name":        "column2",
+			"column.1.type":        "VARCHAR",
+			"column.1.nullable":    "true",
+			"primary_key.#":        "1",
+			"primary_key.0.name":   "pk",
+			"primary_key.0.keys.#": "1",
+			"primary_key.0.keys.0": "column1",
+			"unique_key.#":         "1",
+			"unique_key.0.name":    "uk",
+			"unique_key.0.keys.#":  "1",
+			"unique_key.0.keys.0":  "column2",
+			"data_retention_days":  "1",
+			"change_tracking":      "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+		"column": []interface{}{
+			map[string]interface{}{"name": "column1", "type": "OBJECT", "nullable": false},
+			map[string]interface{}{"name": "column2", "type": "VARCHAR", "nullable": false},
+		},
+		"primary_key": []interface{}{map[string]interface{}{"name": "pk", "keys": []interface{}{"column1", "column2"}}},
+		"foreign_key": []interface{}{map[string]interface{}{
+			"name":       "fk_parent",
+			"keys":       []interface{}{"column2"},
+			"references": []interface{}{map[string]interface{}{"database": "database_name", "schema": "schema_name", "table": "parent", "keys": []interface{}{"id"}}},
+		}},
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" DROP CONSTRAINT "uk"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" DROP CONSTRAINT "pk"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" ALTER COLUMN "column2" SET NOT NULL$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" ADD CONSTRAINT "pk" PRIMARY KEY \("column1", "column2"\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."good_name" ADD CONSTRAINT "fk_parent" FOREIGN KEY \("column2"\) REFERENCES "database_name"."schema_name"."parent" \("id"\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.UpdateTable(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestTableNullablePrimaryKey(t *testing.T) {
+	r := require.New(t)
+
+	res := resources.Table()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "good_name",
+		"database":    "database_name",
+		"schema":      "schema_name",
+		"column":      []interface{}{map[string]interface{}{"name": "column1", "type": "OBJECT"}},
+		"primary_key": []interface{}{map[string]interface{}{"name": "pk", "keys": []interface{}{"column1"}}},
+	})
+	_, err := res.Diff(context.Background(), &terraform.InstanceState{}, config, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "column column1 is part of the primary key")
+}
+
+func TestTableUpdateRename(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "good_name",
+		"database": "database_name",
+		"schema":   "schema_name",
+	}
+	d := table(t, "database_name|schema_name|old_name", in)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "database_name"."schema_name"."old_name" RENAME TO "database_name"."schema_name"."good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectTableRead(mock)
+		diags := resources.UpdateTable(context.Background(), d, db)
+		r.Empty(diags)
+		r.Equal("database_name|schema_name|good_name", d.Id())
 	})
 }
 
@@ -80,7 +604,7 @@ func TestTableDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP TABLE "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteTable(d, db)
-		r.NoError(err)
+		diags := resources.DeleteTable(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
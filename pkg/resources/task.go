@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -122,7 +124,7 @@ func difference(a, b map[string]interface{}) map[string]interface{} {
 }
 
 // getActiveRootTask tries to retrieve the root of current task or returns the current (standalone) task
-func getActiveRootTask(data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
+func getActiveRootTask(ctx context.Context, data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
 	log.Println("[DEBUG] retrieving root task")
 
 	db := meta.(*sql.DB)
@@ -144,7 +146,7 @@ func getActiveRootTask(data *schema.ResourceData, meta interface{}) (*snowflake.
 	for {
 		builder := snowflake.Task(name, database, dbSchema)
 		q := builder.Show()
-		row := snowflake.QueryRow(db, q)
+		row := snowflake.QueryRow(ctx, db, q)
 		task, err := snowflake.ScanTask(row)
 
 		if err != nil && name != data.Get("name").(string) {
@@ -165,18 +167,18 @@ func getActiveRootTask(data *schema.ResourceData, meta interface{}) (*snowflake.
 }
 
 // getActiveRootTaskAndSuspend retrieves the root task and suspends it
-func getActiveRootTaskAndSuspend(data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
+func getActiveRootTaskAndSuspend(ctx context.Context, data *schema.ResourceData, meta interface{}) (*snowflake.TaskBuilder, error) {
 	db := meta.(*sql.DB)
 	name := data.Get("name").(string)
 
-	root, err := getActiveRootTask(data, meta)
+	root, err := getActiveRootTask(ctx, data, meta)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error retrieving root task %v", name)
 	}
 
 	if root != nil {
 		qr := root.Suspend()
-		err = snowflake.Exec(db, qr)
+		err = snowflake.Exec(ctx, db, qr)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error suspending root task %v", name)
 		}
@@ -185,20 +187,33 @@ func getActiveRootTaskAndSuspend(data *schema.ResourceData, meta interface{}) (*
 	return root, nil
 }
 
-func resumeTask(root *snowflake.TaskBuilder, meta interface{}) {
+func resumeTask(ctx context.Context, root *snowflake.TaskBuilder, meta interface{}) error {
 	if root == nil {
-		return
+		return nil
 	}
 
 	if root.IsDisabled() {
-		return
+		return nil
 	}
 
 	db := meta.(*sql.DB)
 	qr := root.Resume()
-	err := snowflake.Exec(db, qr)
+	err := snowflake.Exec(ctx, db, qr)
 	if err != nil {
-		log.Fatal(errors.Wrapf(err, "error resuming root task %v", root.QualifiedName()))
+		return errors.Wrapf(err, "error resuming root task %v", root.QualifiedName())
+	}
+	return nil
+}
+
+// deferResumeTask resumes root once the caller returns, adding the error to
+// its diagnostics if that fails. The resume runs on a context that keeps the
+// execution role but not the cancellation of ctx, so that an interrupted or
+// timed out operation doesn't leave the root task suspended.
+func deferResumeTask(ctx context.Context, root *snowflake.TaskBuilder, meta interface{}, diags *diag.Diagnostics) {
+	ctx = snowflake.WithRole(context.Background(), snowflake.RoleFromContext(ctx))
+	err := resumeTask(ctx, root, meta)
+	if err != nil {
+		*diags = append(*diags, diag.FromErr(err)...)
 	}
 }
 
@@ -230,10 +245,10 @@ func taskIDFromString(stringID string) (*taskID, error) {
 // Task returns a pointer to the resource representing a task
 func Task() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateTask,
-		Read:   ReadTask,
-		Update: UpdateTask,
-		Delete: DeleteTask,
+		CreateContext: CreateTask,
+		ReadContext:   ReadTask,
+		UpdateContext: UpdateTask,
+		DeleteContext: DeleteTask,
 
 		Schema: taskSchema,
 		Importer: &schema.ResourceImporter{
@@ -242,12 +257,12 @@ func Task() *schema.Resource {
 	}
 }
 
-// ReadTask implements schema.ReadFunc
-func ReadTask(d *schema.ResourceData, meta interface{}) error {
+// ReadTask implements schema.ReadContextFunc
+func ReadTask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	taskID, err := taskIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	database := taskID.DatabaseName
@@ -256,7 +271,7 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.Task(name, database, schema)
 	q := builder.Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRow(ctx, db, q)
 	t, err := snowflake.ScanTask(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -265,69 +280,69 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("enabled", t.IsEnabled())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", t.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("database", t.DatabaseName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", t.SchemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("warehouse", t.Warehouse)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schedule", t.Schedule)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", t.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if t.Predecessors != nil {
 		err = d.Set("after", t.GetPredecessorName())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	err = d.Set("when", t.Condition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("sql_statement", t.Definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	q = builder.ShowParameters()
-	paramRows, err := snowflake.Query(db, q)
+	paramRows, err := snowflake.Query(ctx, db, q)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	params, err := snowflake.ScanTaskParameters(paramRows)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(params) > 0 {
@@ -343,15 +358,15 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 
 		err := d.Set("session_parameters", paramMap)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-// CreateTask implements schema.CreateFunc
-func CreateTask(d *schema.ResourceData, meta interface{}) error {
+// CreateTask implements schema.CreateContextFunc
+func CreateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 
 	var err error
 	db := meta.(*sql.DB)
@@ -384,11 +399,11 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("after"); ok {
-		root, err := getActiveRootTaskAndSuspend(d, meta)
+		root, err := getActiveRootTaskAndSuspend(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		defer resumeTask(root, meta)
+		defer deferResumeTask(ctx, root, meta, &diags)
 
 		builder.WithDependency(v.(string))
 	}
@@ -398,16 +413,16 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	q := builder.Create()
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating task %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating task %v", name))
 	}
 
 	if enabled {
 		q = builder.Resume()
-		err = snowflake.Exec(db, q)
+		err = snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error starting task %v", name)
+			return diag.FromErr(errors.Wrapf(err, "error starting task %v", name))
 		}
 	}
 
@@ -418,18 +433,18 @@ func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := taskID.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadTask(d, meta)
+	return ReadTask(ctx, d, meta)
 }
 
-// UpdateTask implements schema.UpdateFunc
-func UpdateTask(d *schema.ResourceData, meta interface{}) error {
+// UpdateTask implements schema.UpdateContextFunc
+func UpdateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	taskID, err := taskIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	db := meta.(*sql.DB)
@@ -438,18 +453,18 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 	name := taskID.TaskName
 	builder := snowflake.Task(name, database, dbSchema)
 
-	root, err := getActiveRootTaskAndSuspend(d, meta)
+	root, err := getActiveRootTaskAndSuspend(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	defer resumeTask(root, meta)
+	defer deferResumeTask(ctx, root, meta, &diags)
 
 	if d.HasChange("warehouse") {
 		new := d.Get("warehouse")
 		q := builder.ChangeWarehouse(new.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating warehouse on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating warehouse on task %v", d.Id()))
 		}
 	}
 
@@ -461,9 +476,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.ChangeSchedule(new.(string))
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating schedule on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating schedule on task %v", d.Id()))
 		}
 	}
 
@@ -475,9 +490,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.ChangeTimeout(new.(int))
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating user task timeout on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating user task timeout on task %v", d.Id()))
 		}
 	}
 
@@ -489,9 +504,9 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			q = builder.ChangeComment(new.(string))
 		}
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating comment on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating comment on task %v", d.Id()))
 		}
 	}
 
@@ -506,26 +521,26 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 
 		if enabled {
 			q = builder.Suspend()
-			err = snowflake.Exec(db, q)
+			err = snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error suspending task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error suspending task %v", d.Id()))
 			}
-			defer resumeTask(builder, meta)
+			defer deferResumeTask(ctx, builder, meta, &diags)
 		}
 
 		if old != "" {
 			q = builder.RemoveDependency(old.(string))
-			err = snowflake.Exec(db, q)
+			err = snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error removing old after dependency from task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error removing old after dependency from task %v", d.Id()))
 			}
 		}
 
 		if new != "" {
 			q = builder.AddDependency(new.(string))
-			err := snowflake.Exec(db, q)
+			err := snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error adding after dependency on task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error adding after dependency on task %v", d.Id()))
 			}
 		}
 	}
@@ -548,17 +563,17 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 
 		if len(remove) > 0 {
 			q = builder.RemoveSessionParameters(remove)
-			err := snowflake.Exec(db, q)
+			err := snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error removing session_parameters on task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error removing session_parameters on task %v", d.Id()))
 			}
 		}
 
 		if len(add) > 0 {
 			q = builder.AddSessionParameters(add)
-			err := snowflake.Exec(db, q)
+			err := snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error adding session_parameters to task %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error adding session_parameters to task %v", d.Id()))
 			}
 		}
 	}
@@ -566,18 +581,18 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("when") {
 		new := d.Get("when")
 		q := builder.ChangeCondition(new.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating when condition on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating when condition on task %v", d.Id()))
 		}
 	}
 
 	if d.HasChange("sql_statement") {
 		new := d.Get("sql_statement")
 		q := builder.ChangeSqlStatement(new.(string))
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating sql statement on task %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating sql statement on task %v", d.Id()))
 		}
 	}
 
@@ -597,70 +612,44 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		err := snowflake.Exec(db, q)
+		err := snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error updating task state %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error updating task state %v", d.Id()))
 		}
 	}
 
-	return ReadTask(d, meta)
+	return ReadTask(ctx, d, meta)
 }
 
-// DeleteTask implements schema.DeleteFunc
-func DeleteTask(d *schema.ResourceData, meta interface{}) error {
+// DeleteTask implements schema.DeleteContextFunc
+func DeleteTask(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	db := meta.(*sql.DB)
 	taskID, err := taskIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	database := taskID.DatabaseName
 	schema := taskID.SchemaName
 	name := taskID.TaskName
 
-	root, err := getActiveRootTaskAndSuspend(d, meta)
+	root, err := getActiveRootTaskAndSuspend(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// only resume the root when not a standalone task
 	if root != nil && name != root.Name() {
-		defer resumeTask(root, meta)
+		defer deferResumeTask(ctx, root, meta, &diags)
 	}
 
 	q := snowflake.Task(name, database, schema).Drop()
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting task %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting task %v", d.Id()))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-// TaskExists implements schema.ExistsFunc
-func TaskExists(data *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	taskID, err := taskIDFromString(data.Id())
-	if err != nil {
-		return false, err
-	}
-
-	database := taskID.DatabaseName
-	schema := taskID.SchemaName
-	name := taskID.TaskName
-
-	q := snowflake.Task(name, database, schema).Show()
-	rows, err := db.Query(q)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-
-	return false, nil
-}
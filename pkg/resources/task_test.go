@@ -1,8 +1,10 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/provider"
@@ -10,6 +12,7 @@ import (
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,8 +49,8 @@ func TestTaskCreate(t *testing.T) {
 
 		expectReadTask(mock)
 		expectReadTaskParams(mock)
-		err := resources.CreateTask(d, db)
-		r.NoError(err)
+		diags := resources.CreateTask(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -81,8 +84,78 @@ func TestTaskRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Task("test_task", "test_db", "test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadTask(d, db)
+		diags := resources.ReadTask(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
+	})
+}
+
+func TestTaskCreateAfterResumeError(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "test_task",
+		"database":      "test_db",
+		"schema":        "test_schema",
+		"warehouse":     "much_warehouse",
+		"sql_statement": "select hi from hello",
+		"comment":       "wow comment",
+		"after":         "root_task",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.Task().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "warehouse", "schedule", "predecessors", "state", "definition", "condition"},
+		).AddRow("2020-05-14 17:20:50.088 +0000", "root_task", "test_db", "test_schema", "ACCOUNTADMIN", "", "", "", nil, "started", "select 1", "")
+		mock.ExpectQuery(`^SHOW TASKS LIKE 'root_task' IN DATABASE "test_db"$`).WillReturnRows(rows)
+		mock.ExpectExec(`^ALTER TASK "test_db"."test_schema"."root_task" SUSPEND$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			`^CREATE TASK "test_db"."test_schema"."test_task" WAREHOUSE = "much_warehouse" COMMENT = 'wow comment' AFTER "test_db"."test_schema"."root_task" AS select hi from hello$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadTask(mock)
+		expectReadTaskParams(mock)
+		mock.ExpectExec(`^ALTER TASK "test_db"."test_schema"."root_task" RESUME$`).WillReturnError(errors.New("insufficient privileges"))
+
+		diags := resources.CreateTask(context.Background(), d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, `error resuming root task "test_db"."test_schema"."root_task"`)
+	})
+}
+
+func TestTaskCreateCancelledResumesRoot(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":          "test_task",
+		"database":      "test_db",
+		"schema":        "test_schema",
+		"warehouse":     "much_warehouse",
+		"sql_statement": "select hi from hello",
+		"comment":       "wow comment",
+		"after":         "root_task",
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.Task().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		rows := sqlmock.NewRows([]string{
+			"created_on", "name", "database_name", "schema_name", "owner", "comment", "warehouse", "schedule", "predecessors", "state", "definition", "condition"},
+		).AddRow("2020-05-14 17:20:50.088 +0000", "root_task", "test_db", "test_schema", "ACCOUNTADMIN", "", "", "", nil, "started", "select 1", "")
+		mock.ExpectQuery(`^SHOW TASKS LIKE 'root_task' IN DATABASE "test_db"$`).WillReturnRows(rows)
+		mock.ExpectExec(`^ALTER TASK "test_db"."test_schema"."root_task" SUSPEND$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// the operation times out while the root task is suspended
+		mock.ExpectExec(`^CREATE TASK "test_db"."test_schema"."test_task" `).WillDelayFor(time.Second).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TASK "test_db"."test_schema"."root_task" RESUME$`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := resources.CreateTask(ctx, d, db)
+		r.Len(diags, 1)
+		r.Contains(diags[0].Summary, "error creating task test_task")
 	})
 }
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -79,10 +81,10 @@ func DiffSuppressStatement(_, old, new string, d *schema.ResourceData) bool {
 // View returns a pointer to the resource representing a view
 func View() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateView,
-		Read:   ReadView,
-		Update: UpdateView,
-		Delete: DeleteView,
+		CreateContext: CreateView,
+		ReadContext:   ReadView,
+		UpdateContext: UpdateView,
+		DeleteContext: DeleteView,
 
 		Schema: viewSchema,
 		Importer: &schema.ResourceImporter{
@@ -91,8 +93,8 @@ func View() *schema.Resource {
 	}
 }
 
-// CreateView implements schema.CreateFunc
-func CreateView(d *schema.ResourceData, meta interface{}) error {
+// CreateView implements schema.CreateContextFunc
+func CreateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
@@ -116,28 +118,28 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 
 	q, err := builder.Create()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error creating view %v", name)
+		return diag.FromErr(errors.Wrapf(err, "error creating view %v", name))
 	}
 
 	d.SetId(fmt.Sprintf("%v|%v|%v", database, schema, name))
 
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
 }
 
-// ReadView implements schema.ReadFunc
-func ReadView(d *schema.ResourceData, meta interface{}) error {
+// ReadView implements schema.ReadContextFunc
+func ReadView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	dbName, schema, view, err := splitViewID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	q := snowflake.View(view).WithDB(dbName).WithSchema(schema).Show()
-	row := snowflake.QueryRow(db, q)
+	row := snowflake.QueryRow(ctx, db, q)
 	v, err := snowflake.ScanView(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -146,27 +148,27 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", v.Name.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("is_secure", v.IsSecure)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("comment", v.Comment.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("schema", v.SchemaName.String)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Want to only capture the Select part of the query because before that is the Create part of the view which we no longer care about
@@ -174,22 +176,25 @@ func ReadView(d *schema.ResourceData, meta interface{}) error {
 	extractor := snowflake.NewViewSelectStatementExtractor(v.Text.String)
 	substringOfQuery, err := extractor.Extract()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("statement", substringOfQuery)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return d.Set("database", v.DatabaseName.String)
+	if err := d.Set("database", v.DatabaseName.String); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// UpdateView implements schema.UpdateFunc
-func UpdateView(d *schema.ResourceData, meta interface{}) error {
+// UpdateView implements schema.UpdateContextFunc
+func UpdateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dbName, schema, view, err := splitViewID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.View(view).WithDB(dbName).WithSchema(schema)
@@ -200,11 +205,11 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 
 		q, err := builder.Rename(name.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = snowflake.Exec(db, q)
+		err = snowflake.Exec(ctx, db, q)
 		if err != nil {
-			return errors.Wrapf(err, "error renaming view %v", d.Id())
+			return diag.FromErr(errors.Wrapf(err, "error renaming view %v", d.Id()))
 		}
 
 		d.SetId(fmt.Sprintf("%v|%v|%v", dbName, schema, name.(string)))
@@ -216,20 +221,20 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if c := comment.(string); c == "" {
 			q, err := builder.RemoveComment()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting comment for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting comment for view %v", d.Id()))
 			}
 		} else {
 			q, err := builder.ChangeComment(c)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error updating comment for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error updating comment for view %v", d.Id()))
 			}
 		}
 	}
@@ -239,43 +244,43 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 		if secure.(bool) {
 			q, err := builder.Secure()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error setting secure for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error setting secure for view %v", d.Id()))
 			}
 		} else {
 			q, err := builder.Unsecure()
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = snowflake.Exec(db, q)
+			err = snowflake.Exec(ctx, db, q)
 			if err != nil {
-				return errors.Wrapf(err, "error unsetting secure for view %v", d.Id())
+				return diag.FromErr(errors.Wrapf(err, "error unsetting secure for view %v", d.Id()))
 			}
 		}
 	}
 
-	return ReadView(d, meta)
+	return ReadView(ctx, d, meta)
 }
 
-// DeleteView implements schema.DeleteFunc
-func DeleteView(d *schema.ResourceData, meta interface{}) error {
+// DeleteView implements schema.DeleteContextFunc
+func DeleteView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	dbName, schema, view, err := splitViewID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	q, err := snowflake.View(view).WithDB(dbName).WithSchema(schema).Drop()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = snowflake.Exec(db, q)
+	err = snowflake.Exec(ctx, db, q)
 	if err != nil {
-		return errors.Wrapf(err, "error deleting view %v", d.Id())
+		return diag.FromErr(errors.Wrapf(err, "error deleting view %v", d.Id()))
 	}
 
 	d.SetId("")
@@ -283,28 +288,6 @@ func DeleteView(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// ViewExists implements schema.ExistsFunc
-func ViewExists(data *schema.ResourceData, meta interface{}) (bool, error) {
-	db := meta.(*sql.DB)
-	dbName, schema, view, err := splitViewID(data.Id())
-	if err != nil {
-		return false, err
-	}
-
-	q := snowflake.View(view).WithDB(dbName).WithSchema(schema).Show()
-	rows, err := db.Query(q)
-	if err != nil {
-		return false, err
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		return true, nil
-	}
-
-	return false, nil
-}
-
 // splitViewID takes the <database_name>|<schema_name>|<view_name> ID and returns the database
 // name, schema name and view name.
 func splitViewID(v string) (string, string, string, error) {
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -74,9 +77,9 @@ var viewGrantSchema = map[string]*schema.Schema{
 func ViewGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateViewGrant,
-			Read:   ReadViewGrant,
-			Delete: DeleteViewGrant,
+			CreateContext: CreateViewGrant,
+			ReadContext:   ReadViewGrant,
+			DeleteContext: DeleteViewGrant,
 
 			Schema: viewGrantSchema,
 			Importer: &schema.ResourceImporter{
@@ -87,8 +90,8 @@ func ViewGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateViewGrant implements schema.CreateFunc
-func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateViewGrant implements schema.CreateContextFunc
+func CreateViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		viewName   string
 		schemaName string
@@ -109,14 +112,14 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 	grantOption := d.Get("with_grant_option").(bool)
 
 	if (schemaName == "") && !futureViews {
-		return errors.New("schema_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("schema_name must be set unless on_future is true."))
 	}
 
 	if (viewName == "") && !futureViews {
-		return errors.New("view_name must be set unless on_future is true.")
+		return diag.FromErr(errors.New("view_name must be set unless on_future is true."))
 	}
 	if (viewName != "") && futureViews {
-		return errors.New("view_name must be empty if on_future is true.")
+		return diag.FromErr(errors.New("view_name must be empty if on_future is true."))
 	}
 
 	var builder snowflake.GrantBuilder
@@ -126,9 +129,9 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ViewGrant(dbName, schemaName, viewName)
 	}
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -140,18 +143,18 @@ func CreateViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadViewGrant(d, meta)
+	return ReadViewGrant(ctx, d, meta)
 }
 
-// ReadViewGrant implements schema.ReadFunc
-func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadViewGrant implements schema.ReadContextFunc
+func ReadViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -160,11 +163,11 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 
 	err = d.Set("database_name", dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("schema_name", schemaName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	futureViewsEnabled := false
 	if viewName == "" {
@@ -172,19 +175,19 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	err = d.Set("view_name", viewName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("on_future", futureViewsEnabled)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var builder snowflake.GrantBuilder
@@ -194,14 +197,17 @@ func ReadViewGrant(d *schema.ResourceData, meta interface{}) error {
 		builder = snowflake.ViewGrant(dbName, schemaName, viewName)
 	}
 
-	return readGenericGrant(d, meta, viewGrantSchema, builder, futureViewsEnabled, validViewPrivileges)
+	if err := readGenericGrant(ctx, d, meta, viewGrantSchema, builder, futureViewsEnabled, validViewPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteViewGrant implements schema.DeleteFunc
-func DeleteViewGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteViewGrant implements schema.DeleteContextFunc
+func DeleteViewGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	dbName := grantID.ResourceName
 	schemaName := grantID.SchemaName
@@ -215,5 +221,8 @@ func DeleteViewGrant(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		builder = snowflake.ViewGrant(dbName, schemaName, viewName)
 	}
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
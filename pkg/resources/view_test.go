@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -41,8 +42,8 @@ func TestViewCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadView(mock)
-		err := resources.CreateView(d, db)
-		r.NoError(err)
+		diags := resources.CreateView(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 func TestViewCreateOrReplace(t *testing.T) {
@@ -66,8 +67,8 @@ func TestViewCreateOrReplace(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadView(mock)
-		err := resources.CreateView(d, db)
-		r.NoError(err)
+		diags := resources.CreateView(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 func TestViewCreateAmpersand(t *testing.T) {
@@ -90,8 +91,8 @@ func TestViewCreateAmpersand(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadView(mock)
-		err := resources.CreateView(d, db)
-		r.NoError(err)
+		diags := resources.CreateView(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -144,8 +145,8 @@ func TestViewRead(t *testing.T) {
 		q := snowflake.View("good_name").WithDB("test_db").WithSchema("test_schema").Show()
 		fmt.Println(q)
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadView(d, db)
+		diags := resources.ReadView(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err)
+		r.Empty(diags)
 	})
 }
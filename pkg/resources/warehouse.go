@@ -1,22 +1,35 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
 	"log"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
 )
 
 // warehouseCreateProperties are only available via the CREATE statement
-var warehouseCreateProperties = []string{"initially_suspended", "wait_for_provisioning", "statement_timeout_in_seconds"}
+var warehouseCreateProperties = []string{"initially_suspended", "wait_for_provisioning"}
 
 var warehouseProperties = []string{
 	"comment", "warehouse_size", "max_cluster_count", "min_cluster_count",
 	"scaling_policy", "auto_suspend", "auto_resume",
-	"resource_monitor",
+	"resource_monitor", "warehouse_type", "enable_query_acceleration",
+	"query_acceleration_max_scale_factor",
+}
+
+// warehouseParameters are object parameters rather than properties of the
+// warehouse. They are read back from SHOW PARAMETERS and unset when removed from
+// the config, so that the warehouse falls back to the account's value.
+var warehouseParameters = []string{
+	"max_concurrency_level", "statement_queued_timeout_in_seconds", "statement_timeout_in_seconds",
 }
 
 var warehouseSchema = map[string]*schema.Schema{
@@ -103,35 +116,78 @@ var warehouseSchema = map[string]*schema.Schema{
 		ForceNew:    false,
 		Description: "Specifies the time, in seconds, after which a running SQL statement (query, DDL, DML, etc.) is canceled by the system",
 	},
+	"statement_queued_timeout_in_seconds": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Object parameter that specifies the time, in seconds, a SQL statement (query, DDL, DML, etc.) can be queued on a warehouse before it is canceled by the system. Uses the account's value if unset.",
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"max_concurrency_level": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Object parameter that specifies the concurrency level for SQL statements (i.e. queries and DML) executed by a warehouse. Uses the account's value if unset.",
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"warehouse_type": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies a STANDARD or SNOWPARK-OPTIMIZED warehouse",
+		ValidateFunc: validation.StringInSlice([]string{"STANDARD", "SNOWPARK-OPTIMIZED"}, true),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"enable_query_acceleration": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Specifies whether to enable the query acceleration service for queries that rely on this warehouse for compute resources.",
+	},
+	"query_acceleration_max_scale_factor": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Specifies the maximum scale factor for leasing compute resources for query acceleration. The scale factor is used as a multiplier based on warehouse size.",
+		ValidateFunc: validation.IntBetween(0, 100),
+	},
 }
 
 // Warehouse returns a pointer to the resource representing a warehouse
 func Warehouse() *schema.Resource {
 	return &schema.Resource{
-		Create: CreateWarehouse,
-		Read:   ReadWarehouse,
-		Delete: DeleteWarehouse,
-		Update: UpdateWarehouse,
+		CreateContext: CreateWarehouse,
+		ReadContext:   ReadWarehouse,
+		DeleteContext: DeleteWarehouse,
+		UpdateContext: UpdateWarehouse,
 
 		Schema: warehouseSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+
+		// Resizing with wait_for_provisioning blocks until all servers are provisioned.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
-// CreateWarehouse implements schema.CreateFunc
-func CreateWarehouse(d *schema.ResourceData, meta interface{}) error {
+// CreateWarehouse implements schema.CreateContextFunc
+func CreateWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	props := append(warehouseProperties, warehouseCreateProperties...)
-	return CreateResource("warehouse", props, warehouseSchema, snowflake.Warehouse, ReadWarehouse)(d, meta)
+	props = append(props, warehouseParameters...)
+	return CreateResource("warehouse", props, warehouseSchema, snowflake.Warehouse, ReadWarehouse)(ctx, d, meta)
 }
 
-// ReadWarehouse implements schema.ReadFunc
-func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
+// ReadWarehouse implements schema.ReadContextFunc
+func ReadWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	db := meta.(*sql.DB)
 	stmt := snowflake.Warehouse(d.Id()).Show()
 
-	row := snowflake.QueryRow(db, stmt)
+	row := snowflake.QueryRow(ctx, db, stmt)
 	w, err := snowflake.ScanWarehouse(row)
 	if err == sql.ErrNoRows {
 		// If not found, mark resource to be removed from statefile during apply or refresh
@@ -140,52 +196,147 @@ func ReadWarehouse(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("name", w.Name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("comment", w.Comment)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("warehouse_size", w.Size)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("max_cluster_count", w.MaxClusterCount)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("min_cluster_count", w.MinClusterCount)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("scaling_policy", w.ScalingPolicy)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("auto_suspend", w.AutoSuspend)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("auto_resume", w.AutoResume)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("resource_monitor", w.ResourceMonitor)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("warehouse_type", w.Type)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("enable_query_acceleration", w.EnableQueryAcceleration)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("query_acceleration_max_scale_factor", w.QueryAccelerationMaxScaleFactor)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := readWarehouseParameters(ctx, d, db); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// readWarehouseParameters sets the warehouse parameters that are set on the
+// warehouse itself. Inherited values are read as unset so that they don't show
+// up as a diff against a config that omits them.
+func readWarehouseParameters(ctx context.Context, d *schema.ResourceData, db *sql.DB) error {
+	stmt := snowflake.Warehouse(d.Id()).ShowParameters()
+	rows, err := snowflake.Query(ctx, db, stmt)
+	if err != nil {
+		return errors.Wrap(err, "error reading warehouse parameters")
+	}
+	defer rows.Close()
+
+	params, err := snowflake.ScanParameters(rows)
+	if err != nil {
+		return errors.Wrap(err, "error reading warehouse parameters")
+	}
+
+	values := map[string]int{}
+	for _, p := range params {
+		key := strings.ToLower(p.Key)
+		if !p.IsSetOn(snowflake.WarehouseType) {
+			continue
+		}
+		v, err := strconv.Atoi(p.Value)
+		if err != nil {
+			return errors.Wrapf(err, "unexpected value %q for warehouse parameter %s", p.Value, p.Key)
+		}
+		values[key] = v
+	}
 
-	return err
+	for _, key := range warehouseParameters {
+		if err := d.Set(key, values[key]); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// UpdateWarehouse implements schema.UpdateFunc
-func UpdateWarehouse(d *schema.ResourceData, meta interface{}) error {
-	return UpdateResource("warehouse", warehouseProperties, warehouseSchema, snowflake.Warehouse, ReadWarehouse)(d, meta)
+// UpdateWarehouse implements schema.UpdateContextFunc
+func UpdateWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// parameters are updated after any rename, before reading the warehouse back
+	read := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := updateWarehouseParameters(ctx, d, meta.(*sql.DB)); err != nil {
+			return diag.FromErr(err)
+		}
+		return ReadWarehouse(ctx, d, meta)
+	}
+	return UpdateResource("warehouse", warehouseProperties, warehouseSchema, snowflake.Warehouse, read)(ctx, d, meta)
+}
+
+// updateWarehouseParameters sets the changed parameters and unsets the ones
+// that were removed from the config.
+func updateWarehouseParameters(ctx context.Context, d *schema.ResourceData, db *sql.DB) error {
+	builder := snowflake.Warehouse(d.Get("name").(string))
+	set := builder.Alter()
+	var changed bool
+	var unset []string
+
+	for _, key := range warehouseParameters {
+		if !d.HasChange(key) {
+			continue
+		}
+		if v, ok := d.GetOk(key); ok {
+			set.SetInt(key, v.(int))
+			changed = true
+		} else {
+			unset = append(unset, key)
+		}
+	}
+
+	if changed {
+		if err := snowflake.Exec(ctx, db, set.Statement()); err != nil {
+			return errors.Wrap(err, "error setting warehouse parameters")
+		}
+	}
+	if len(unset) > 0 {
+		if err := snowflake.Exec(ctx, db, builder.Unset(unset...)); err != nil {
+			return errors.Wrap(err, "error unsetting warehouse parameters")
+		}
+	}
+	return nil
 }
 
-// DeleteWarehouse implements schema.DeleteFunc
-func DeleteWarehouse(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("warehouse", snowflake.Warehouse)(d, meta)
+// DeleteWarehouse implements schema.DeleteContextFunc
+func DeleteWarehouse(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return DeleteResource("warehouse", snowflake.Warehouse)(ctx, d, meta)
 }
@@ -1,8 +1,11 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -48,9 +51,9 @@ var warehouseGrantSchema = map[string]*schema.Schema{
 func WarehouseGrant() *TerraformGrantResource {
 	return &TerraformGrantResource{
 		Resource: &schema.Resource{
-			Create: CreateWarehouseGrant,
-			Read:   ReadWarehouseGrant,
-			Delete: DeleteWarehouseGrant,
+			CreateContext: CreateWarehouseGrant,
+			ReadContext:   ReadWarehouseGrant,
+			DeleteContext: DeleteWarehouseGrant,
 
 			Schema: warehouseGrantSchema,
 			// FIXME - tests for this don't currently work
@@ -62,16 +65,16 @@ func WarehouseGrant() *TerraformGrantResource {
 	}
 }
 
-// CreateWarehouseGrant implements schema.CreateFunc
-func CreateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// CreateWarehouseGrant implements schema.CreateContextFunc
+func CreateWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	w := d.Get("warehouse_name").(string)
 	priv := d.Get("privilege").(string)
 	grantOption := d.Get("with_grant_option").(bool)
 	builder := snowflake.WarehouseGrant(w)
 
-	err := createGenericGrant(d, meta, builder)
+	err := createGenericGrant(ctx, d, meta, builder)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	grant := &grantID{
@@ -81,49 +84,55 @@ func CreateWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
 	}
 	dataIDInput, err := grant.String()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dataIDInput)
 
-	return ReadWarehouseGrant(d, meta)
+	return ReadWarehouseGrant(ctx, d, meta)
 }
 
-// ReadWarehouseGrant implements schema.ReadFunc
-func ReadWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// ReadWarehouseGrant implements schema.ReadContextFunc
+func ReadWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 	priv := grantID.Privilege
 
 	err = d.Set("warehouse_name", w)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("privilege", priv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = d.Set("with_grant_option", grantID.GrantOption)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builder := snowflake.WarehouseGrant(w)
 
-	return readGenericGrant(d, meta, warehouseGrantSchema, builder, false, validWarehousePrivileges)
+	if err := readGenericGrant(ctx, d, meta, warehouseGrantSchema, builder, false, validWarehousePrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
-// DeleteWarehouseGrant implements schema.DeleteFunc
-func DeleteWarehouseGrant(d *schema.ResourceData, meta interface{}) error {
+// DeleteWarehouseGrant implements schema.DeleteContextFunc
+func DeleteWarehouseGrant(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	grantID, err := grantIDFromString(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	w := grantID.ResourceName
 
 	builder := snowflake.WarehouseGrant(w)
 
-	return deleteGenericGrant(d, meta, builder)
+	if err := deleteGenericGrant(ctx, d, meta, builder); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
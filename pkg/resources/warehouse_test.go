@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,14 +34,41 @@ func TestWarehouseCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE WAREHOUSE "good_name" COMMENT='great comment`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadWarehouse(mock)
-		err := resources.CreateWarehouse(d, db)
-		r.NoError(err)
+		diags := resources.CreateWarehouse(context.Background(), d, db)
+		r.Empty(diags)
+	})
+}
+
+func TestWarehouseCreateCancelled(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name": "good_name",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Warehouse().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		diags := resources.CreateWarehouse(ctx, d, db)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, context.Canceled.Error())
+		r.Empty(d.Id())
 	})
 }
 
 func expectReadWarehouse(mock sqlmock.Sqlmock) {
-	rows := sqlmock.NewRows([]string{"name", "comment", "size"}).AddRow("good_name", "mock comment", "SMALL")
+	rows := sqlmock.NewRows([]string{
+		"name", "comment", "size", "type", "enable_query_acceleration", "query_acceleration_max_scale_factor"},
+	).AddRow("good_name", "mock comment", "SMALL", "STANDARD", "true", 4)
 	mock.ExpectQuery("SHOW WAREHOUSES LIKE 'good_name'").WillReturnRows(rows)
+
+	params := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("MAX_CONCURRENCY_LEVEL", "4", "8", "WAREHOUSE", "desc", "NUMBER").
+		AddRow("STATEMENT_QUEUED_TIMEOUT_IN_SECONDS", "600", "0", "ACCOUNT", "desc", "NUMBER").
+		AddRow("STATEMENT_TIMEOUT_IN_SECONDS", "172800", "172800", "", "desc", "NUMBER")
+	mock.ExpectQuery(`SHOW PARAMETERS IN WAREHOUSE "good_name"`).WillReturnRows(params)
 }
 
 func TestWarehouseRead(t *testing.T) {
@@ -49,17 +78,55 @@ func TestWarehouseRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadWarehouse(mock)
-		err := resources.ReadWarehouse(d, db)
-		r.NoError(err)
+		diags := resources.ReadWarehouse(context.Background(), d, db)
+		r.Empty(diags)
 		r.Equal("mock comment", d.Get("comment").(string))
+		r.Equal("STANDARD", d.Get("warehouse_type").(string))
+		r.True(d.Get("enable_query_acceleration").(bool))
+		r.Equal(4, d.Get("query_acceleration_max_scale_factor").(int))
+
+		// only parameters set on the warehouse itself are read back
+		r.Equal(4, d.Get("max_concurrency_level").(int))
+		r.Equal(0, d.Get("statement_queued_timeout_in_seconds").(int))
+		r.Equal(0, d.Get("statement_timeout_in_seconds").(int))
 
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
 		q := snowflake.Warehouse(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadWarehouse(d, db)
+		diags2 := resources.ReadWarehouse(context.Background(), d, db)
 		r.Empty(d.State())
-		r.Nil(err2)
+		r.Empty(diags2)
+	})
+}
+
+func TestWarehouseUpdateParameters(t *testing.T) {
+	r := require.New(t)
+
+	// max_concurrency_level is removed from the config and the queue timeout is added
+	res := resources.Warehouse()
+	state := &terraform.InstanceState{
+		ID: "good_name",
+		Attributes: map[string]string{
+			"name":                  "good_name",
+			"max_concurrency_level": "4",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                                "good_name",
+		"statement_queued_timeout_in_seconds": 60,
+	})
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	r.NoError(err)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER WAREHOUSE "good_name" SET STATEMENT_QUEUED_TIMEOUT_IN_SECONDS=60$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER WAREHOUSE "good_name" UNSET MAX_CONCURRENCY_LEVEL$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadWarehouse(mock)
+		diags := resources.UpdateWarehouse(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
 
@@ -70,7 +137,7 @@ func TestWarehouseDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP WAREHOUSE "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteWarehouse(d, db)
-		r.NoError(err)
+		diags := resources.DeleteWarehouse(context.Background(), d, db)
+		r.Empty(diags)
 	})
 }
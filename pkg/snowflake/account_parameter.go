@@ -0,0 +1,34 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccountParameterBuilder abstracts the creation of SQL queries that set and
+// read an account parameter
+type AccountParameterBuilder struct {
+	key string
+}
+
+// AccountParameter returns a pointer to an AccountParameterBuilder for the
+// parameter key, e.g. TIMEZONE
+func AccountParameter(key string) *AccountParameterBuilder {
+	return &AccountParameterBuilder{key: strings.ToUpper(key)}
+}
+
+// Set returns the SQL query that sets the parameter to value, a literal as
+// returned by ParameterDefinition.Literal.
+func (b *AccountParameterBuilder) Set(value string) string {
+	return fmt.Sprintf(`ALTER ACCOUNT SET %v = %v`, b.key, value)
+}
+
+// Unset returns the SQL query that resets the parameter to its default.
+func (b *AccountParameterBuilder) Unset() string {
+	return fmt.Sprintf(`ALTER ACCOUNT UNSET %v`, b.key)
+}
+
+// Show returns the SQL query that reads the parameter.
+func (b *AccountParameterBuilder) Show() string {
+	return Show("PARAMETERS").Like(b.key).InAccount().Statement()
+}
@@ -0,0 +1,46 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountParameter(t *testing.T) {
+	r := require.New(t)
+	b := snowflake.AccountParameter("timezone")
+
+	r.Equal(`ALTER ACCOUNT SET TIMEZONE = 'America/Los_Angeles'`, b.Set(`'America/Los_Angeles'`))
+	r.Equal(`ALTER ACCOUNT UNSET TIMEZONE`, b.Unset())
+	r.Equal(`SHOW PARAMETERS LIKE 'TIMEZONE' IN ACCOUNT`, b.Show())
+}
+
+func TestParameterDefinition(t *testing.T) {
+	r := require.New(t)
+
+	b := snowflake.AccountParameters["PERIODIC_DATA_REKEYING"]
+	r.NoError(b.Validate("TRUE"))
+	r.Error(b.Validate("yes"))
+	r.Equal("true", b.Literal("true"))
+	r.Equal("true", b.Literal("1"))
+	r.Equal("false", b.Literal("F"))
+	r.True(b.Equal("true", "TRUE"))
+	r.True(b.Equal("1", "true"))
+	r.True(b.Equal("t", "TRUE"))
+	r.False(b.Equal("t", "false"))
+
+	n := snowflake.AccountParameters["STATEMENT_TIMEOUT_IN_SECONDS"]
+	r.NoError(n.Validate("3600"))
+	r.Error(n.Validate("1h"))
+	r.Equal("3600", n.Literal("3600"))
+
+	s := snowflake.AccountParameters["TIMEZONE"]
+	r.Equal(`'it\'s'`, s.Literal("it's"))
+	r.False(s.Equal("UTC", "utc"))
+
+	e := snowflake.AccountParameters["TIMESTAMP_TYPE_MAPPING"]
+	r.NoError(e.Validate("timestamp_ntz"))
+	r.Error(e.Validate("TIMESTAMP"))
+	r.Equal(`'TIMESTAMP_NTZ'`, e.Literal("TIMESTAMP_NTZ"))
+}
@@ -0,0 +1,17 @@
+package snowflake
+
+import "context"
+
+type roleContextKey struct{}
+
+// WithRole returns a copy of ctx that asks the connection to run statements as
+// role. An empty role leaves the session's default role in effect.
+func WithRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, roleContextKey{}, role)
+}
+
+// RoleFromContext returns the role set by WithRole, or "" if there is none.
+func RoleFromContext(ctx context.Context) string {
+	role, _ := ctx.Value(roleContextKey{}).(string)
+	return role
+}
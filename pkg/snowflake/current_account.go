@@ -0,0 +1,64 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SelectCurrentAccount returns the query reading the account, region, role and
+// user of the session
+func SelectCurrentAccount() string {
+	return `SELECT CURRENT_ACCOUNT() AS "account", CURRENT_REGION() AS "region", CURRENT_ROLE() AS "role", CURRENT_USER() AS "user"`
+}
+
+// SelectCurrentOrganizationAccount returns the query reading the account of the
+// session as organization.account, the way replication lists accounts
+func SelectCurrentOrganizationAccount() string {
+	return `SELECT CURRENT_ORGANIZATION_NAME() || '.' || CURRENT_ACCOUNT_NAME() AS "account"`
+}
+
+type currentAccount struct {
+	Account string         `db:"account"`
+	Region  string         `db:"region"`
+	Role    sql.NullString `db:"role"`
+	User    string         `db:"user"`
+}
+
+// ScanCurrentAccount converts the result of SelectCurrentAccount into a
+// currentAccount
+func ScanCurrentAccount(row *sqlx.Row) (*currentAccount, error) {
+	a := &currentAccount{}
+	err := row.StructScan(a)
+	return a, err
+}
+
+// URL returns the https URL of the account, taken from the deployment hosts of
+// allowlist, the result of SYSTEM$ALLOWLIST, since the host name of the
+// account is only listed there
+func (a *currentAccount) URL(allowlist *allowlistRaw) (string, error) {
+	entries, err := allowlist.GetStructuredConfig()
+	if err != nil {
+		return "", err
+	}
+
+	host := ""
+	for _, e := range entries {
+		if e.Type != "SNOWFLAKE_DEPLOYMENT" {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(e.Host), strings.ToLower(a.Account)+".") {
+			host = e.Host
+			break
+		}
+		if host == "" {
+			host = e.Host
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("no deployment host found for account %v", a.Account)
+	}
+	return fmt.Sprintf("https://%v", host), nil
+}
@@ -0,0 +1,23 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentAccountURL(t *testing.T) {
+	r := require.New(t)
+
+	a := &currentAccount{Account: "AB12345"}
+	allowlist := &allowlistRaw{
+		Allowlist: `[{"type":"SNOWFLAKE_DEPLOYMENT_REGIONLESS","host":"myorg-prod.snowflakecomputing.com","port":443},{"type":"STAGE","host":"sfc-stage.s3.amazonaws.com","port":443},{"type":"SNOWFLAKE_DEPLOYMENT","host":"ab12345.us-east-2.aws.snowflakecomputing.com","port":443}]`,
+	}
+	url, err := a.URL(allowlist)
+	r.NoError(err)
+	r.Equal("https://ab12345.us-east-2.aws.snowflakecomputing.com", url)
+
+	allowlist.Allowlist = `[{"type":"OCSP_CACHE","host":"ocsp.snowflakecomputing.com","port":80}]`
+	_, err = a.URL(allowlist)
+	r.Error(err)
+}
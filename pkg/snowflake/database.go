@@ -1,9 +1,11 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -40,8 +42,9 @@ func (dsb *DatabaseShareBuilder) Create() string {
 
 // DatabaseCloneBuilder is a basic builder that just creates databases from a source database
 type DatabaseCloneBuilder struct {
-	name     string
-	database string
+	name      string
+	database  string
+	transient bool
 }
 
 // DatabaseFromDatabase returns a pointer to a builder that can create a database from a source database
@@ -52,11 +55,91 @@ func DatabaseFromDatabase(name, database string) *DatabaseCloneBuilder {
 	}
 }
 
+// Transient makes the clone a transient database
+func (dsb *DatabaseCloneBuilder) Transient() *DatabaseCloneBuilder {
+	dsb.transient = true
+	return dsb
+}
+
 // Create returns the SQL statement required to create a database from a source database
 func (dsb *DatabaseCloneBuilder) Create() string {
+	if dsb.transient {
+		return fmt.Sprintf(`CREATE TRANSIENT DATABASE "%v" CLONE "%v"`, dsb.name, dsb.database)
+	}
 	return fmt.Sprintf(`CREATE DATABASE "%v" CLONE "%v"`, dsb.name, dsb.database)
 }
 
+// DatabaseReplicaBuilder is a basic builder that just creates secondary databases
+type DatabaseReplicaBuilder struct {
+	name    string
+	primary string
+}
+
+// DatabaseFromReplica returns a pointer to a builder that can create a secondary
+// database replicating primary, given as organization.account.database
+func DatabaseFromReplica(name, primary string) *DatabaseReplicaBuilder {
+	return &DatabaseReplicaBuilder{
+		name:    name,
+		primary: primary,
+	}
+}
+
+// Create returns the SQL statement required to create a secondary database
+func (drb *DatabaseReplicaBuilder) Create() (string, error) {
+	parts := strings.Split(drb.primary, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("primary database %v must be organization.account.database", drb.primary)
+	}
+	return fmt.Sprintf(`CREATE DATABASE "%v" AS REPLICA OF %v.%v."%v"`, drb.name, parts[0], parts[1], parts[2]), nil
+}
+
+// DatabaseReplicationBuilder abstracts the replication and failover settings of a
+// primary database
+type DatabaseReplicationBuilder struct {
+	name string
+}
+
+// DatabaseReplication returns a pointer to a builder for the replication of a database
+func DatabaseReplication(name string) *DatabaseReplicationBuilder {
+	return &DatabaseReplicationBuilder{
+		name: name,
+	}
+}
+
+// EnableReplication returns the SQL statement that allows replicating the database
+// to accounts, given as organization.account
+func (drb *DatabaseReplicationBuilder) EnableReplication(accounts []string, ignoreEditionCheck bool) string {
+	q := fmt.Sprintf(`ALTER DATABASE "%v" ENABLE REPLICATION TO ACCOUNTS %v`, drb.name, strings.Join(accounts, ", "))
+	if ignoreEditionCheck {
+		q += " IGNORE EDITION CHECK"
+	}
+	return q
+}
+
+// DisableReplication returns the SQL statement that stops replicating the database
+// to accounts
+func (drb *DatabaseReplicationBuilder) DisableReplication(accounts []string) string {
+	return fmt.Sprintf(`ALTER DATABASE "%v" DISABLE REPLICATION TO ACCOUNTS %v`, drb.name, strings.Join(accounts, ", "))
+}
+
+// EnableFailover returns the SQL statement that allows accounts to promote their
+// secondary database to primary
+func (drb *DatabaseReplicationBuilder) EnableFailover(accounts []string) string {
+	return fmt.Sprintf(`ALTER DATABASE "%v" ENABLE FAILOVER TO ACCOUNTS %v`, drb.name, strings.Join(accounts, ", "))
+}
+
+// DisableFailover returns the SQL statement that stops accounts from promoting
+// their secondary database to primary
+func (drb *DatabaseReplicationBuilder) DisableFailover(accounts []string) string {
+	return fmt.Sprintf(`ALTER DATABASE "%v" DISABLE FAILOVER TO ACCOUNTS %v`, drb.name, strings.Join(accounts, ", "))
+}
+
+// Show returns the SQL query listing the primary and secondary databases of
+// the name in the organization
+func (drb *DatabaseReplicationBuilder) Show() string {
+	return fmt.Sprintf(`SHOW REPLICATION DATABASES LIKE '%v'`, drb.name)
+}
+
 type database struct {
 	CreatedOn     sql.NullString `db:"created_on"`
 	DBName        sql.NullString `db:"name"`
@@ -69,15 +152,32 @@ type database struct {
 	RetentionTime sql.NullString `db:"retention_time"`
 }
 
+// IsTransient tells whether the database is a transient one
+func (d *database) IsTransient() bool {
+	for _, opt := range strings.Split(d.Options.String, ", ") {
+		if opt == "TRANSIENT" {
+			return true
+		}
+	}
+	return false
+}
+
 func ScanDatabase(row *sqlx.Row) (*database, error) {
 	d := &database{}
 	e := row.StructScan(d)
 	return d, e
 }
 
-func ListDatabases(sdb *sqlx.DB) ([]database, error) {
+// ScanDatabases turns the rows of a SHOW DATABASES query into databases
+func ScanDatabases(rows *sqlx.Rows) ([]database, error) {
+	dbs := []database{}
+	err := sqlx.StructScan(rows, &dbs)
+	return dbs, err
+}
+
+func ListDatabases(ctx context.Context, sdb *sqlx.DB) ([]database, error) {
 	stmt := "SHOW DATABASES"
-	rows, err := sdb.Queryx(stmt)
+	rows, err := sdb.QueryxContext(ctx, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -91,3 +191,68 @@ func ListDatabases(sdb *sqlx.DB) ([]database, error) {
 	}
 	return dbs, errors.Wrapf(err, "unable to scan row for %s", stmt)
 }
+
+// replicationDatabase is a row of SHOW REPLICATION DATABASES, one per account
+// holding the database or one of its replicas
+type replicationDatabase struct {
+	RegionGroup                  sql.NullString `db:"region_group"`
+	SnowflakeRegion              sql.NullString `db:"snowflake_region"`
+	CreatedOn                    sql.NullString `db:"created_on"`
+	AccountName                  sql.NullString `db:"account_name"`
+	Name                         sql.NullString `db:"name"`
+	Comment                      sql.NullString `db:"comment"`
+	IsPrimary                    sql.NullBool   `db:"is_primary"`
+	Primary                      sql.NullString `db:"primary"`
+	ReplicationAllowedToAccounts sql.NullString `db:"replication_allowed_to_accounts"`
+	FailoverAllowedToAccounts    sql.NullString `db:"failover_allowed_to_accounts"`
+	OrganizationName             sql.NullString `db:"organization_name"`
+	AccountLocator               sql.NullString `db:"account_locator"`
+}
+
+// Account returns the organization.account holding the database
+func (r *replicationDatabase) Account() string {
+	return fmt.Sprintf("%v.%v", r.OrganizationName.String, r.AccountName.String)
+}
+
+// ReplicationAccounts returns the other accounts the database can be replicated to
+func (r *replicationDatabase) ReplicationAccounts() []string {
+	return otherAccounts(r.ReplicationAllowedToAccounts.String, r.Account())
+}
+
+// FailoverAccounts returns the other accounts that can promote their replica of
+// the database to primary
+func (r *replicationDatabase) FailoverAccounts() []string {
+	return otherAccounts(r.FailoverAllowedToAccounts.String, r.Account())
+}
+
+// otherAccounts returns the accounts of the comma separated list but account,
+// which Snowflake lists too when it holds the primary object
+func otherAccounts(list, account string) []string {
+	accounts := []string{}
+	for _, a := range splitList(list) {
+		if !strings.EqualFold(a, account) {
+			accounts = append(accounts, a)
+		}
+	}
+	return accounts
+}
+
+// splitList returns the items of a comma separated list of a SHOW query
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ScanReplicationDatabases turns the rows of a SHOW REPLICATION DATABASES query
+// into replication databases
+func ScanReplicationDatabases(rows *sqlx.Rows) ([]replicationDatabase, error) {
+	dbs := []replicationDatabase{}
+	err := sqlx.StructScan(rows, &dbs)
+	return dbs, err
+}
@@ -1,6 +1,7 @@
 package snowflake_test
 
 import (
+	"context"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -20,6 +21,9 @@ func TestDatabase(t *testing.T) {
 	q = db.Drop()
 	r.Equal(`DROP DATABASE "db1"`, q)
 
+	q = db.Undrop()
+	r.Equal(`UNDROP DATABASE "db1"`, q)
+
 	q = db.Rename("db2")
 	r.Equal(`ALTER DATABASE "db1" RENAME TO "db2"`, q)
 
@@ -47,6 +51,12 @@ func TestDatabase(t *testing.T) {
 	c2.SetString("foo", "ba'r")
 	q = c2.Statement()
 	r.Equal(`CREATE DATABASE "db1" FOO='ba\'r'`, q)
+
+	c3 := db.Create()
+	c3.Transient()
+	c3.SetInt("data_retention_time_in_days", 0)
+	q = c3.Statement()
+	r.Equal(`CREATE TRANSIENT DATABASE "db1" DATA_RETENTION_TIME_IN_DAYS=0`, q)
 }
 
 func TestDatabaseCreateFromShare(t *testing.T) {
@@ -61,6 +71,56 @@ func TestDatabaseCreateFromDatabase(t *testing.T) {
 	db := snowflake.DatabaseFromDatabase("db1", "abc123")
 	q := db.Create()
 	r.Equal(`CREATE DATABASE "db1" CLONE "abc123"`, q)
+
+	q = db.Transient().Create()
+	r.Equal(`CREATE TRANSIENT DATABASE "db1" CLONE "abc123"`, q)
+}
+
+func TestDatabaseCreateFromReplica(t *testing.T) {
+	r := require.New(t)
+	db := snowflake.DatabaseFromReplica("db1", "MYORG.ACCOUNT1.db1")
+	q, err := db.Create()
+	r.NoError(err)
+	r.Equal(`CREATE DATABASE "db1" AS REPLICA OF MYORG.ACCOUNT1."db1"`, q)
+
+	_, err = snowflake.DatabaseFromReplica("db1", "db1").Create()
+	r.Error(err)
+
+	_, err = snowflake.DatabaseFromReplica("db1", "MYORG.ACCOUNT1.db1.extra").Create()
+	r.Error(err)
+}
+
+func TestDatabaseReplication(t *testing.T) {
+	r := require.New(t)
+	db := snowflake.DatabaseReplication("db1")
+	accounts := []string{"MYORG.ACCOUNT2", "MYORG.ACCOUNT3"}
+
+	r.Equal(`ALTER DATABASE "db1" ENABLE REPLICATION TO ACCOUNTS MYORG.ACCOUNT2, MYORG.ACCOUNT3`, db.EnableReplication(accounts, false))
+	r.Equal(`ALTER DATABASE "db1" ENABLE REPLICATION TO ACCOUNTS MYORG.ACCOUNT2, MYORG.ACCOUNT3 IGNORE EDITION CHECK`, db.EnableReplication(accounts, true))
+	r.Equal(`ALTER DATABASE "db1" DISABLE REPLICATION TO ACCOUNTS MYORG.ACCOUNT2, MYORG.ACCOUNT3`, db.DisableReplication(accounts))
+	r.Equal(`ALTER DATABASE "db1" ENABLE FAILOVER TO ACCOUNTS MYORG.ACCOUNT2, MYORG.ACCOUNT3`, db.EnableFailover(accounts))
+	r.Equal(`ALTER DATABASE "db1" DISABLE FAILOVER TO ACCOUNTS MYORG.ACCOUNT2, MYORG.ACCOUNT3`, db.DisableFailover(accounts))
+	r.Equal(`SHOW REPLICATION DATABASES LIKE 'db1'`, db.Show())
+}
+
+func TestScanReplicationDatabases(t *testing.T) {
+	r := require.New(t)
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	rows := sqlmock.NewRows([]string{"region_group", "snowflake_region", "created_on", "account_name", "name", "comment", "is_primary", "primary", "replication_allowed_to_accounts", "failover_allowed_to_accounts", "organization_name", "account_locator"}).
+		AddRow("PUBLIC", "AWS_US_WEST_2", "", "ACCOUNT1", "db1", "", "true", "MYORG.ACCOUNT1.db1", "MYORG.ACCOUNT1, MYORG.ACCOUNT2, MYORG.ACCOUNT3", "MYORG.ACCOUNT2", "MYORG", "AB12345")
+	mock.ExpectQuery(`SHOW REPLICATION DATABASES LIKE 'db1'`).WillReturnRows(rows)
+	res, err := sqlxDB.Queryx(`SHOW REPLICATION DATABASES LIKE 'db1'`)
+	r.NoError(err)
+	dbs, err := snowflake.ScanReplicationDatabases(res)
+	r.NoError(err)
+	r.Len(dbs, 1)
+	r.True(dbs[0].IsPrimary.Bool)
+	r.Equal("MYORG.ACCOUNT1", dbs[0].Account())
+	r.Equal([]string{"MYORG.ACCOUNT2", "MYORG.ACCOUNT3"}, dbs[0].ReplicationAccounts())
+	r.Equal([]string{"MYORG.ACCOUNT2"}, dbs[0].FailoverAccounts())
 }
 
 func TestListDatabases(t *testing.T) {
@@ -71,6 +131,6 @@ func TestListDatabases(t *testing.T) {
 	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
 	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time"}).AddRow("", "", "", "", "", "", "", "", "")
 	mock.ExpectQuery(`SHOW DATABASES`).WillReturnRows(rows)
-	_, err = snowflake.ListDatabases(sqlxDB)
+	_, err = snowflake.ListDatabases(context.Background(), sqlxDB)
 	r.NoError(err)
 }
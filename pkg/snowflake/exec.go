@@ -1,29 +1,34 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
 	"github.com/jmoiron/sqlx"
 )
 
-func Exec(db *sql.DB, query string) error {
-	log.Print("[DEBUG] exec stmt ", query)
+// Exec runs query against the db. The statement is cancelled if ctx is done
+// before it completes.
+func Exec(ctx context.Context, db *sql.DB, query string) error {
+	log.Print("[DEBUG] exec stmt ", RedactSecrets(query))
 
-	_, err := db.Exec(query)
+	_, err := db.ExecContext(ctx, query)
 	return err
 }
 
-func ExecMulti(db *sql.DB, queries []string) error {
-	log.Print("[DEBUG] exec stmts ", queries)
+// ExecMulti runs queries in a single transaction, rolling back if any of them
+// fail or ctx is done before the transaction commits.
+func ExecMulti(ctx context.Context, db *sql.DB, queries []string) error {
+	log.Print("[DEBUG] exec stmts ", redactSecretsList(queries))
 
-	tx, err := db.Begin()
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
 	for _, query := range queries {
-		_, err = tx.Exec(query)
+		_, err = tx.ExecContext(ctx, query)
 		if err != nil {
 			return tx.Rollback()
 		}
@@ -34,16 +39,16 @@ func ExecMulti(db *sql.DB, queries []string) error {
 // QueryRow will run stmt against the db and return the row. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns
-func QueryRow(db *sql.DB, stmt string) *sqlx.Row {
-	log.Print("[DEBUG] query stmt ", stmt)
+func QueryRow(ctx context.Context, db *sql.DB, stmt string) *sqlx.Row {
+	log.Print("[DEBUG] query stmt ", RedactSecrets(stmt))
 	sdb := sqlx.NewDb(db, "snowflake").Unsafe()
-	return sdb.QueryRowx(stmt)
+	return sdb.QueryRowxContext(ctx, stmt)
 }
 
 // Query will run stmt against the db and return the rows. We use
 // [DB.Unsafe](https://godoc.org/github.com/jmoiron/sqlx#DB.Unsafe) so that we can scan to structs
 // without worrying about newly introduced columns
-func Query(db *sql.DB, stmt string) (*sqlx.Rows, error) {
+func Query(ctx context.Context, db *sql.DB, stmt string) (*sqlx.Rows, error) {
 	sdb := sqlx.NewDb(db, "snowflake").Unsafe()
-	return sdb.Queryx(stmt)
+	return sdb.QueryxContext(ctx, stmt)
 }
@@ -0,0 +1,301 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// FailoverGroupBuilder abstracts the creation of SQL queries for a Snowflake
+// failover group or replication group. Both replicate a set of account objects
+// to other accounts of the organization, but only failover groups can be
+// promoted to primary in a secondary account.
+type FailoverGroupBuilder struct {
+	kind                    string
+	name                    string
+	objectTypes             []string
+	allowedDatabases        []string
+	allowedShares           []string
+	allowedIntegrationTypes []string
+	allowedAccounts         []string
+	ignoreEditionCheck      bool
+	replicationSchedule     string
+}
+
+// FailoverGroup returns a pointer to a Builder that abstracts the DDL operations for a failover group.
+//
+// Supported DDL operations are:
+//   - CREATE FAILOVER GROUP
+//   - ALTER FAILOVER GROUP
+//   - DROP FAILOVER GROUP
+//   - SHOW FAILOVER GROUPS
+//   - SHOW DATABASES IN FAILOVER GROUP
+//   - SHOW SHARES IN FAILOVER GROUP
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/user-guide/account-replication-intro.html)
+func FailoverGroup(name string) *FailoverGroupBuilder {
+	return &FailoverGroupBuilder{
+		kind: "FAILOVER GROUP",
+		name: name,
+	}
+}
+
+// ReplicationGroup returns a pointer to a Builder that abstracts the DDL
+// operations for a replication group, which support the same operations as
+// failover groups.
+func ReplicationGroup(name string) *FailoverGroupBuilder {
+	return &FailoverGroupBuilder{
+		kind: "REPLICATION GROUP",
+		name: name,
+	}
+}
+
+// QualifiedName returns the quoted name of the group
+func (fb *FailoverGroupBuilder) QualifiedName() string {
+	return fmt.Sprintf(`"%v"`, EscapeString(fb.name))
+}
+
+// WithObjectTypes adds the types of the objects to replicate, e.g. DATABASES or ROLES
+func (fb *FailoverGroupBuilder) WithObjectTypes(t []string) *FailoverGroupBuilder {
+	fb.objectTypes = t
+	return fb
+}
+
+// WithAllowedDatabases adds the databases to replicate
+func (fb *FailoverGroupBuilder) WithAllowedDatabases(d []string) *FailoverGroupBuilder {
+	fb.allowedDatabases = d
+	return fb
+}
+
+// WithAllowedShares adds the shares to replicate
+func (fb *FailoverGroupBuilder) WithAllowedShares(s []string) *FailoverGroupBuilder {
+	fb.allowedShares = s
+	return fb
+}
+
+// WithAllowedIntegrationTypes adds the types of the integrations to replicate,
+// e.g. SECURITY INTEGRATIONS
+func (fb *FailoverGroupBuilder) WithAllowedIntegrationTypes(t []string) *FailoverGroupBuilder {
+	fb.allowedIntegrationTypes = t
+	return fb
+}
+
+// WithAllowedAccounts adds the accounts to replicate to, given as organization.account
+func (fb *FailoverGroupBuilder) WithAllowedAccounts(a []string) *FailoverGroupBuilder {
+	fb.allowedAccounts = a
+	return fb
+}
+
+// WithIgnoreEditionCheck allows replicating to accounts on lower editions
+func (fb *FailoverGroupBuilder) WithIgnoreEditionCheck(i bool) *FailoverGroupBuilder {
+	fb.ignoreEditionCheck = i
+	return fb
+}
+
+// WithReplicationSchedule adds the schedule refreshing the secondary groups,
+// e.g. 10 MINUTE or USING CRON 0 0 * * * UTC
+func (fb *FailoverGroupBuilder) WithReplicationSchedule(s string) *FailoverGroupBuilder {
+	fb.replicationSchedule = s
+	return fb
+}
+
+// Create returns the SQL query that will create a new primary group.
+func (fb *FailoverGroupBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE %v %v OBJECT_TYPES = %v`, fb.kind, fb.QualifiedName(), strings.Join(fb.objectTypes, ", ")))
+
+	if len(fb.allowedDatabases) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_DATABASES = %v`, quoteIdentifiers(fb.allowedDatabases)))
+	}
+
+	if len(fb.allowedShares) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_SHARES = %v`, quoteIdentifiers(fb.allowedShares)))
+	}
+
+	if len(fb.allowedIntegrationTypes) > 0 {
+		q.WriteString(fmt.Sprintf(` ALLOWED_INTEGRATION_TYPES = %v`, strings.Join(fb.allowedIntegrationTypes, ", ")))
+	}
+
+	q.WriteString(fmt.Sprintf(` ALLOWED_ACCOUNTS = %v`, strings.Join(fb.allowedAccounts, ", ")))
+
+	if fb.ignoreEditionCheck {
+		q.WriteString(` IGNORE EDITION CHECK`)
+	}
+
+	if fb.replicationSchedule != "" {
+		q.WriteString(fmt.Sprintf(` REPLICATION_SCHEDULE = '%v'`, EscapeString(fb.replicationSchedule)))
+	}
+
+	return q.String()
+}
+
+// CreateReplica returns the SQL query that will create a secondary group
+// replicating primary, given as organization.account.group
+func (fb *FailoverGroupBuilder) CreateReplica(primary string) (string, error) {
+	parts := strings.Split(primary, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("primary group %v must be organization.account.group", primary)
+	}
+	return fmt.Sprintf(`CREATE %v %v AS REPLICA OF %v.%v."%v"`, fb.kind, fb.QualifiedName(), parts[0], parts[1], EscapeString(parts[2])), nil
+}
+
+// Rename returns the SQL query that will rename the group.
+func (fb *FailoverGroupBuilder) Rename(newName string) string {
+	return fmt.Sprintf(`ALTER %v %v RENAME TO "%v"`, fb.kind, fb.QualifiedName(), EscapeString(newName))
+}
+
+// ChangeObjectTypes returns the SQL query that will replace the types of the replicated objects.
+func (fb *FailoverGroupBuilder) ChangeObjectTypes(t []string) string {
+	return fmt.Sprintf(`ALTER %v %v SET OBJECT_TYPES = %v`, fb.kind, fb.QualifiedName(), strings.Join(t, ", "))
+}
+
+// ChangeAllowedIntegrationTypes returns the SQL query that will replace the types of the replicated integrations.
+func (fb *FailoverGroupBuilder) ChangeAllowedIntegrationTypes(t []string) string {
+	return fmt.Sprintf(`ALTER %v %v SET ALLOWED_INTEGRATION_TYPES = %v`, fb.kind, fb.QualifiedName(), strings.Join(t, ", "))
+}
+
+// RemoveAllowedIntegrationTypes returns the SQL query that will stop replicating integrations of any type.
+func (fb *FailoverGroupBuilder) RemoveAllowedIntegrationTypes() string {
+	return fmt.Sprintf(`ALTER %v %v UNSET ALLOWED_INTEGRATION_TYPES`, fb.kind, fb.QualifiedName())
+}
+
+// AddAllowedDatabases returns the SQL query that will add databases to the group.
+func (fb *FailoverGroupBuilder) AddAllowedDatabases(d []string) string {
+	return fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_DATABASES`, fb.kind, fb.QualifiedName(), quoteIdentifiers(d))
+}
+
+// RemoveAllowedDatabases returns the SQL query that will remove databases from the group.
+func (fb *FailoverGroupBuilder) RemoveAllowedDatabases(d []string) string {
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_DATABASES`, fb.kind, fb.QualifiedName(), quoteIdentifiers(d))
+}
+
+// AddAllowedShares returns the SQL query that will add shares to the group.
+func (fb *FailoverGroupBuilder) AddAllowedShares(s []string) string {
+	return fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_SHARES`, fb.kind, fb.QualifiedName(), quoteIdentifiers(s))
+}
+
+// RemoveAllowedShares returns the SQL query that will remove shares from the group.
+func (fb *FailoverGroupBuilder) RemoveAllowedShares(s []string) string {
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_SHARES`, fb.kind, fb.QualifiedName(), quoteIdentifiers(s))
+}
+
+// AddAllowedAccounts returns the SQL query that will allow replicating the group to accounts.
+func (fb *FailoverGroupBuilder) AddAllowedAccounts(a []string, ignoreEditionCheck bool) string {
+	q := fmt.Sprintf(`ALTER %v %v ADD %v TO ALLOWED_ACCOUNTS`, fb.kind, fb.QualifiedName(), strings.Join(a, ", "))
+	if ignoreEditionCheck {
+		q += ` IGNORE EDITION CHECK`
+	}
+	return q
+}
+
+// RemoveAllowedAccounts returns the SQL query that will stop replicating the group to accounts.
+func (fb *FailoverGroupBuilder) RemoveAllowedAccounts(a []string) string {
+	return fmt.Sprintf(`ALTER %v %v REMOVE %v FROM ALLOWED_ACCOUNTS`, fb.kind, fb.QualifiedName(), strings.Join(a, ", "))
+}
+
+// ChangeReplicationSchedule returns the SQL query that will update the replication schedule of the group.
+func (fb *FailoverGroupBuilder) ChangeReplicationSchedule(s string) string {
+	return fmt.Sprintf(`ALTER %v %v SET REPLICATION_SCHEDULE = '%v'`, fb.kind, fb.QualifiedName(), EscapeString(s))
+}
+
+// RemoveReplicationSchedule returns the SQL query that will remove the replication schedule of the group.
+func (fb *FailoverGroupBuilder) RemoveReplicationSchedule() string {
+	return fmt.Sprintf(`ALTER %v %v UNSET REPLICATION_SCHEDULE`, fb.kind, fb.QualifiedName())
+}
+
+// Drop returns the SQL query that will drop the group.
+func (fb *FailoverGroupBuilder) Drop() string {
+	return fmt.Sprintf(`DROP %v %v`, fb.kind, fb.QualifiedName())
+}
+
+// Show returns the SQL query that will list the groups of the kind, which
+// can't be filtered by name.
+func (fb *FailoverGroupBuilder) Show() string {
+	return fmt.Sprintf(`SHOW %vS`, fb.kind)
+}
+
+// ShowDatabases returns the SQL query that will list the databases of the group.
+func (fb *FailoverGroupBuilder) ShowDatabases() string {
+	return fmt.Sprintf(`SHOW DATABASES IN %v %v`, fb.kind, fb.QualifiedName())
+}
+
+// ShowShares returns the SQL query that will list the shares of the group.
+func (fb *FailoverGroupBuilder) ShowShares() string {
+	return fmt.Sprintf(`SHOW SHARES IN %v %v`, fb.kind, fb.QualifiedName())
+}
+
+// failoverGroup is a row of SHOW FAILOVER GROUPS or SHOW REPLICATION GROUPS,
+// one per account holding the group or one of its replicas
+type failoverGroup struct {
+	RegionGroup             sql.NullString `db:"region_group"`
+	SnowflakeRegion         sql.NullString `db:"snowflake_region"`
+	CreatedOn               sql.NullString `db:"created_on"`
+	AccountName             sql.NullString `db:"account_name"`
+	Name                    sql.NullString `db:"name"`
+	Type                    sql.NullString `db:"type"`
+	Comment                 sql.NullString `db:"comment"`
+	IsPrimary               sql.NullBool   `db:"is_primary"`
+	Primary                 sql.NullString `db:"primary"`
+	ObjectTypes             sql.NullString `db:"object_types"`
+	AllowedIntegrationTypes sql.NullString `db:"allowed_integration_types"`
+	AllowedAccounts         sql.NullString `db:"allowed_accounts"`
+	OrganizationName        sql.NullString `db:"organization_name"`
+	AccountLocator          sql.NullString `db:"account_locator"`
+	ReplicationSchedule     sql.NullString `db:"replication_schedule"`
+	SecondaryState          sql.NullString `db:"secondary_state"`
+	NextScheduledRefresh    sql.NullString `db:"next_scheduled_refresh"`
+	Owner                   sql.NullString `db:"owner"`
+}
+
+// Account returns the organization.account holding the group
+func (fg *failoverGroup) Account() string {
+	return fmt.Sprintf("%v.%v", fg.OrganizationName.String, fg.AccountName.String)
+}
+
+// GetObjectTypes returns the types of the objects replicated by the group
+func (fg *failoverGroup) GetObjectTypes() []string {
+	return splitList(fg.ObjectTypes.String)
+}
+
+// GetAllowedIntegrationTypes returns the types of the integrations replicated by the group
+func (fg *failoverGroup) GetAllowedIntegrationTypes() []string {
+	return splitList(fg.AllowedIntegrationTypes.String)
+}
+
+// GetAllowedAccounts returns the other accounts the group is replicated to
+func (fg *failoverGroup) GetAllowedAccounts() []string {
+	return otherAccounts(fg.AllowedAccounts.String, fg.Account())
+}
+
+// ScanFailoverGroups turns the rows of a SHOW FAILOVER GROUPS or SHOW
+// REPLICATION GROUPS query into groups
+func ScanFailoverGroups(rows *sqlx.Rows) ([]failoverGroup, error) {
+	groups := []failoverGroup{}
+	err := sqlx.StructScan(rows, &groups)
+	return groups, err
+}
+
+// failoverGroupObject is a row of SHOW DATABASES or SHOW SHARES in a group
+type failoverGroupObject struct {
+	Name sql.NullString `db:"name"`
+}
+
+// ScanFailoverGroupObjects returns the names of the objects listed by a SHOW
+// DATABASES or SHOW SHARES query in a group. Shares are listed with the
+// account holding them, which is left out.
+func ScanFailoverGroupObjects(rows *sqlx.Rows) ([]string, error) {
+	objects := []failoverGroupObject{}
+	err := sqlx.StructScan(rows, &objects)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(objects))
+	for _, o := range objects {
+		name := o.Name.String
+		names = append(names, name[strings.LastIndex(name, ".")+1:])
+	}
+	return names, nil
+}
@@ -0,0 +1,81 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverGroupCreate(t *testing.T) {
+	r := require.New(t)
+	fg := FailoverGroup("test_group").
+		WithObjectTypes([]string{"DATABASES", "INTEGRATIONS", "ROLES"}).
+		WithAllowedDatabases([]string{"db1", "db2"}).
+		WithAllowedIntegrationTypes([]string{"SECURITY INTEGRATIONS"}).
+		WithAllowedAccounts([]string{"MYORG.ACCOUNT2", "MYORG.ACCOUNT3"})
+	r.Equal(`CREATE FAILOVER GROUP "test_group" OBJECT_TYPES = DATABASES, INTEGRATIONS, ROLES ALLOWED_DATABASES = "db1", "db2" ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS ALLOWED_ACCOUNTS = MYORG.ACCOUNT2, MYORG.ACCOUNT3`, fg.Create())
+
+	fg.WithAllowedShares([]string{"share1"}).WithIgnoreEditionCheck(true).WithReplicationSchedule("10 MINUTE")
+	r.Equal(`CREATE FAILOVER GROUP "test_group" OBJECT_TYPES = DATABASES, INTEGRATIONS, ROLES ALLOWED_DATABASES = "db1", "db2" ALLOWED_SHARES = "share1" ALLOWED_INTEGRATION_TYPES = SECURITY INTEGRATIONS ALLOWED_ACCOUNTS = MYORG.ACCOUNT2, MYORG.ACCOUNT3 IGNORE EDITION CHECK REPLICATION_SCHEDULE = '10 MINUTE'`, fg.Create())
+
+	rg := ReplicationGroup("test_group").WithObjectTypes([]string{"WAREHOUSES"}).WithAllowedAccounts([]string{"MYORG.ACCOUNT2"})
+	r.Equal(`CREATE REPLICATION GROUP "test_group" OBJECT_TYPES = WAREHOUSES ALLOWED_ACCOUNTS = MYORG.ACCOUNT2`, rg.Create())
+}
+
+func TestFailoverGroupCreateReplica(t *testing.T) {
+	r := require.New(t)
+	q, err := FailoverGroup("test_group").CreateReplica("MYORG.ACCOUNT1.test_group")
+	r.NoError(err)
+	r.Equal(`CREATE FAILOVER GROUP "test_group" AS REPLICA OF MYORG.ACCOUNT1."test_group"`, q)
+
+	q, err = ReplicationGroup("test_group").CreateReplica("MYORG.ACCOUNT1.test_group")
+	r.NoError(err)
+	r.Equal(`CREATE REPLICATION GROUP "test_group" AS REPLICA OF MYORG.ACCOUNT1."test_group"`, q)
+
+	_, err = FailoverGroup("test_group").CreateReplica("test_group")
+	r.Error(err)
+}
+
+func TestFailoverGroupAlter(t *testing.T) {
+	r := require.New(t)
+	fg := FailoverGroup("test_group")
+	r.Equal(`ALTER FAILOVER GROUP "test_group" RENAME TO "new_group"`, fg.Rename("new_group"))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" SET OBJECT_TYPES = DATABASES, SHARES`, fg.ChangeObjectTypes([]string{"DATABASES", "SHARES"}))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" SET ALLOWED_INTEGRATION_TYPES = API INTEGRATIONS, STORAGE INTEGRATIONS`, fg.ChangeAllowedIntegrationTypes([]string{"API INTEGRATIONS", "STORAGE INTEGRATIONS"}))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" UNSET ALLOWED_INTEGRATION_TYPES`, fg.RemoveAllowedIntegrationTypes())
+	r.Equal(`ALTER FAILOVER GROUP "test_group" ADD "db1", "db2" TO ALLOWED_DATABASES`, fg.AddAllowedDatabases([]string{"db1", "db2"}))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" REMOVE "db1" FROM ALLOWED_DATABASES`, fg.RemoveAllowedDatabases([]string{"db1"}))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" ADD "share1" TO ALLOWED_SHARES`, fg.AddAllowedShares([]string{"share1"}))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" REMOVE "share1" FROM ALLOWED_SHARES`, fg.RemoveAllowedShares([]string{"share1"}))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" ADD MYORG.ACCOUNT2 TO ALLOWED_ACCOUNTS`, fg.AddAllowedAccounts([]string{"MYORG.ACCOUNT2"}, false))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" ADD MYORG.ACCOUNT2 TO ALLOWED_ACCOUNTS IGNORE EDITION CHECK`, fg.AddAllowedAccounts([]string{"MYORG.ACCOUNT2"}, true))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" REMOVE MYORG.ACCOUNT2, MYORG.ACCOUNT3 FROM ALLOWED_ACCOUNTS`, fg.RemoveAllowedAccounts([]string{"MYORG.ACCOUNT2", "MYORG.ACCOUNT3"}))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" SET REPLICATION_SCHEDULE = 'USING CRON 0 0 * * * UTC'`, fg.ChangeReplicationSchedule("USING CRON 0 0 * * * UTC"))
+	r.Equal(`ALTER FAILOVER GROUP "test_group" UNSET REPLICATION_SCHEDULE`, fg.RemoveReplicationSchedule())
+}
+
+func TestFailoverGroupDropAndShow(t *testing.T) {
+	r := require.New(t)
+	fg := FailoverGroup("test_group")
+	r.Equal(`DROP FAILOVER GROUP "test_group"`, fg.Drop())
+	r.Equal(`SHOW FAILOVER GROUPS`, fg.Show())
+	r.Equal(`SHOW DATABASES IN FAILOVER GROUP "test_group"`, fg.ShowDatabases())
+	r.Equal(`SHOW SHARES IN FAILOVER GROUP "test_group"`, fg.ShowShares())
+
+	rg := ReplicationGroup("test_group")
+	r.Equal(`DROP REPLICATION GROUP "test_group"`, rg.Drop())
+	r.Equal(`SHOW REPLICATION GROUPS`, rg.Show())
+	r.Equal(`SHOW DATABASES IN REPLICATION GROUP "test_group"`, rg.ShowDatabases())
+}
+
+func TestFailoverGroupAccounts(t *testing.T) {
+	r := require.New(t)
+	fg := failoverGroup{}
+	fg.OrganizationName.String = "MYORG"
+	fg.AccountName.String = "ACCOUNT1"
+	fg.ObjectTypes.String = "DATABASES, ROLES"
+	fg.AllowedAccounts.String = "MYORG.ACCOUNT1, MYORG.ACCOUNT2"
+	r.Equal([]string{"DATABASES", "ROLES"}, fg.GetObjectTypes())
+	r.Equal([]string{}, fg.GetAllowedIntegrationTypes())
+	r.Equal([]string{"MYORG.ACCOUNT2"}, fg.GetAllowedAccounts())
+}
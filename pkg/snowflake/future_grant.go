@@ -2,6 +2,7 @@ package snowflake
 
 import (
 	"fmt"
+	"time"
 )
 
 type futureGrantType string
@@ -26,6 +27,18 @@ const (
 	futureDatabaseTarget futureGrantTarget = "DATABASE"
 )
 
+// FutureGrant represents the columns in the response from `SHOW FUTURE GRANTS
+// IN SCHEMA...` and can be used in conjunction with sqlx.
+type FutureGrant struct {
+	CreatedOn   time.Time `db:"created_on"`
+	Privilege   string    `db:"privilege"`
+	GrantType   string    `db:"grant_on"`
+	GrantName   string    `db:"name"`
+	GranteeType string    `db:"grant_to"`
+	GranteeName string    `db:"grantee_name"`
+	GrantOption bool      `db:"grant_option"`
+}
+
 // FutureGrantBuilder abstracts the creation of FutureGrantExecutables
 type FutureGrantBuilder struct {
 	name              string
@@ -230,3 +243,10 @@ func (fge *FutureGrantExecutable) Revoke(p string) []string {
 func (fge *FutureGrantExecutable) Show() string {
 	return fmt.Sprintf(`SHOW FUTURE GRANTS IN %v %v`, fge.futureGrantTarget, fge.grantName)
 }
+
+// ShowFutureGrantsIn returns the SQL that will show all future grants in a
+// schema, or in a database if schema is empty
+func ShowFutureGrantsIn(db, schema string) string {
+	_, qualifiedName, futureTarget := getNameAndQualifiedName(db, schema)
+	return fmt.Sprintf(`SHOW FUTURE GRANTS IN %v %v`, futureTarget, qualifiedName)
+}
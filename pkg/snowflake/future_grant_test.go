@@ -149,6 +149,12 @@ func TestShowFutureGrantsInSchema(t *testing.T) {
 	r.Equal(`SHOW FUTURE GRANTS IN DATABASE "test_db"`, s)
 }
 
+func TestShowFutureGrantsIn(t *testing.T) {
+	r := require.New(t)
+	r.Equal(`SHOW FUTURE GRANTS IN SCHEMA "test_db"."PUBLIC"`, snowflake.ShowFutureGrantsIn("test_db", "PUBLIC"))
+	r.Equal(`SHOW FUTURE GRANTS IN DATABASE "test_db"`, snowflake.ShowFutureGrantsIn("test_db", ""))
+}
+
 func TestFutureExternalTableGrant(t *testing.T) {
 	r := require.New(t)
 	fvg := snowflake.FutureExternalTableGrant("test_db", "PUBLIC")
@@ -15,10 +15,12 @@ const (
 	ManagedAccountType          EntityType = "MANAGED ACCOUNT"
 	ResourceMonitorType         EntityType = "RESOURCE MONITOR"
 	RoleType                    EntityType = "ROLE"
+	SchemaType                  EntityType = "SCHEMA"
 	ShareType                   EntityType = "SHARE"
 	StorageIntegrationType      EntityType = "STORAGE INTEGRATION"
 	NotificationIntegrationType EntityType = "NOTIFICATION INTEGRATION"
 	SecurityIntegrationType     EntityType = "SECURITY INTEGRATION"
+	TableType                   EntityType = "TABLE"
 	UserType                    EntityType = "USER"
 	WarehouseType               EntityType = "WAREHOUSE"
 )
@@ -40,10 +42,30 @@ func (b *Builder) Drop() string {
 	return fmt.Sprintf(`DROP %s "%s"`, b.entityType, b.name)
 }
 
+// Undrop returns the statement that restores the most recently dropped entity
+// with the name.
+func (b *Builder) Undrop() string {
+	return fmt.Sprintf(`UNDROP %s "%s"`, b.entityType, b.name)
+}
+
 func (b *Builder) Rename(newName string) string {
 	return fmt.Sprintf(`ALTER %s "%s" RENAME TO "%s"`, b.entityType, b.name, newName)
 }
 
+// ShowParameters returns the query that lists the parameters of the entity.
+func (b *Builder) ShowParameters() string {
+	return fmt.Sprintf(`SHOW PARAMETERS IN %s "%s"`, b.entityType, b.name)
+}
+
+// Unset returns the statement that resets properties to their defaults.
+func (b *Builder) Unset(properties ...string) string {
+	keys := make([]string, len(properties))
+	for i, p := range properties {
+		keys[i] = strings.ToUpper(p)
+	}
+	return fmt.Sprintf(`ALTER %s "%s" UNSET %s`, b.entityType, b.name, strings.Join(keys, ", "))
+}
+
 // SettingBuilder is an interface for a builder that allows you to set key value pairs..
 type SettingBuilder interface {
 	SetString(string, string)
@@ -125,6 +147,7 @@ func (ab *AlterPropertiesBuilder) Statement() string {
 type CreateBuilder struct {
 	name                 string
 	entityType           EntityType
+	transient            bool
 	stringProperties     map[string]string
 	stringListProperties map[string][]string
 	boolProperties       map[string]bool
@@ -144,6 +167,12 @@ func (b *Builder) Create() *CreateBuilder {
 	}
 }
 
+// Transient makes the statement create a transient entity, i.e. one without
+// a Fail-safe period.
+func (b *CreateBuilder) Transient() {
+	b.transient = true
+}
+
 func (b *CreateBuilder) SetString(key, value string) {
 	b.stringProperties[key] = value
 }
@@ -166,7 +195,11 @@ func (b *CreateBuilder) SetFloat(key string, value float64) {
 
 func (b *CreateBuilder) Statement() string {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf(`CREATE %s "%s"`, b.entityType, b.name)) // TODO handle error
+	sb.WriteString("CREATE ")
+	if b.transient {
+		sb.WriteString("TRANSIENT ")
+	}
+	sb.WriteString(fmt.Sprintf(`%s "%s"`, b.entityType, b.name)) // TODO handle error
 
 	sortedStringProperties := make([]string, 0)
 	for k := range b.stringProperties {
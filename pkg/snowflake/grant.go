@@ -3,6 +3,7 @@ package snowflake
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type grantType string
@@ -28,6 +29,20 @@ const (
 	streamType           grantType = "STREAM"
 )
 
+// CurrentGrant represents a generic grant of a privilege from a grant (the target) to a
+// grantee. This type can be used in conjunction with github.com/jmoiron/sqlx to
+// build a nice go representation of a grant
+type CurrentGrant struct {
+	CreatedOn   time.Time `db:"created_on"`
+	Privilege   string    `db:"privilege"`
+	GrantType   string    `db:"granted_on"`
+	GrantName   string    `db:"name"`
+	GranteeType string    `db:"granted_to"`
+	GranteeName string    `db:"grantee_name"`
+	GrantOption bool      `db:"grant_option"`
+	GrantedBy   string    `db:"granted_by"`
+}
+
 type GrantExecutable interface {
 	Grant(p string, w bool) string
 	Revoke(p string) []string
@@ -323,3 +338,26 @@ func (ge *CurrentGrantExecutable) Revoke(p string) []string {
 func (ge *CurrentGrantExecutable) Show() string {
 	return fmt.Sprintf(`SHOW GRANTS OF %v "%v"`, ge.granteeType, ge.granteeName)
 }
+
+// ShowGrantsOn returns the SQL that will show all privileges granted on an
+// object. objectName is the fully qualified name of the object, e.g.
+// db.schema.table, whose parts are quoted; it is ignored for the ACCOUNT
+// object type.
+func ShowGrantsOn(objectType, objectName string) string {
+	if strings.ToUpper(objectType) == string(accountType) {
+		return `SHOW GRANTS ON ACCOUNT`
+	}
+	return fmt.Sprintf(`SHOW GRANTS ON %v %v`, strings.ToUpper(objectType), QualifiedObjectName(strings.Split(objectName, ".")...))
+}
+
+// ShowGrantsToRole returns the SQL that will show all privileges and roles
+// granted to role
+func ShowGrantsToRole(role string) string {
+	return fmt.Sprintf(`SHOW GRANTS TO ROLE "%v"`, role)
+}
+
+// ShowGrantsOfRole returns the SQL that will show all users and roles to
+// which role has been granted
+func ShowGrantsOfRole(role string) string {
+	return fmt.Sprintf(`SHOW GRANTS OF ROLE "%v"`, role)
+}
@@ -317,3 +317,12 @@ func TestShowGrantsOf(t *testing.T) {
 	s = snowflake.ViewGrant("test_db", "PUBLIC", "testView").Share("testShare").Show()
 	r.Equal(`SHOW GRANTS OF SHARE "testShare"`, s)
 }
+
+func TestShowGrants(t *testing.T) {
+	r := require.New(t)
+	r.Equal(`SHOW GRANTS ON ACCOUNT`, snowflake.ShowGrantsOn("account", ""))
+	r.Equal(`SHOW GRANTS ON DATABASE "test_db"`, snowflake.ShowGrantsOn("DATABASE", "test_db"))
+	r.Equal(`SHOW GRANTS ON TABLE "db"."s"."t"`, snowflake.ShowGrantsOn("table", "db.s.t"))
+	r.Equal(`SHOW GRANTS TO ROLE "analyst"`, snowflake.ShowGrantsToRole("analyst"))
+	r.Equal(`SHOW GRANTS OF ROLE "analyst"`, snowflake.ShowGrantsOfRole("analyst"))
+}
@@ -0,0 +1,31 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+)
+
+type descIntegrationRow struct {
+	Property      string         `db:"property"`
+	PropertyValue sql.NullString `db:"property_value"`
+}
+
+// DescIntegration runs a DESCRIBE INTEGRATION query and returns the value of
+// every property it lists, keyed by property name
+func DescIntegration(ctx context.Context, db *sql.DB, query string) (map[string]string, error) {
+	rows, err := Query(ctx, db, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	properties := map[string]string{}
+	for rows.Next() {
+		row := &descIntegrationRow{}
+		if err := rows.StructScan(row); err != nil {
+			return nil, err
+		}
+		properties[row.Property] = row.PropertyValue.String
+	}
+	return properties, rows.Err()
+}
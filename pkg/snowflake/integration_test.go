@@ -0,0 +1,32 @@
+package snowflake_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescIntegration(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"property", "property_type", "property_value", "property_default"}).
+			AddRow("ENABLED", "Boolean", "true", "false").
+			AddRow("STORAGE_AWS_EXTERNAL_ID", "String", "ABC_SFCRole=1_xyz", nil).
+			AddRow("COMMENT", "String", nil, nil)
+		mock.ExpectQuery(`^DESCRIBE STORAGE INTEGRATION "s3"$`).WillReturnRows(rows)
+
+		properties, err := snowflake.DescIntegration(context.Background(), db, snowflake.StorageIntegration("s3").Describe())
+		r.NoError(err)
+		r.Equal(map[string]string{
+			"ENABLED":                 "true",
+			"STORAGE_AWS_EXTERNAL_ID": "ABC_SFCRole=1_xyz",
+			"COMMENT":                 "",
+		}, properties)
+	})
+}
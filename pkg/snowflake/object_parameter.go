@@ -0,0 +1,54 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ObjectParameterBuilder abstracts the creation of SQL queries that set and
+// read a parameter of an object
+type ObjectParameterBuilder struct {
+	objectType EntityType
+	objectName string
+	key        string
+}
+
+// ObjectParameter returns a pointer to an ObjectParameterBuilder for the
+// parameter key of an object. objectName is used as is, so it must be fully
+// qualified and quoted, e.g. "db"."schema"."table".
+func ObjectParameter(objectType EntityType, objectName, key string) *ObjectParameterBuilder {
+	return &ObjectParameterBuilder{
+		objectType: objectType,
+		objectName: objectName,
+		key:        strings.ToUpper(key),
+	}
+}
+
+// QualifiedObjectName quotes and joins the non-empty parts of an object
+// identifier, e.g. "db"."schema"."table".
+func QualifiedObjectName(parts ...string) string {
+	quoted := []string{}
+	for _, p := range parts {
+		if p != "" {
+			quoted = append(quoted, fmt.Sprintf(`"%v"`, p))
+		}
+	}
+	return strings.Join(quoted, ".")
+}
+
+// Set returns the SQL query that sets the parameter to value, a literal as
+// returned by ParameterDefinition.Literal.
+func (b *ObjectParameterBuilder) Set(value string) string {
+	return fmt.Sprintf(`ALTER %v %v SET %v = %v`, b.objectType, b.objectName, b.key, value)
+}
+
+// Unset returns the SQL query that makes the object inherit the parameter
+// again.
+func (b *ObjectParameterBuilder) Unset() string {
+	return fmt.Sprintf(`ALTER %v %v UNSET %v`, b.objectType, b.objectName, b.key)
+}
+
+// Show returns the SQL query that reads the parameter.
+func (b *ObjectParameterBuilder) Show() string {
+	return Show("PARAMETERS").Like(b.key).In(string(b.objectType), b.objectName).Statement()
+}
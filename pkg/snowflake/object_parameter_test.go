@@ -0,0 +1,32 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectParameter(t *testing.T) {
+	r := require.New(t)
+	name := snowflake.QualifiedObjectName("db", "schema", "table")
+	r.Equal(`"db"."schema"."table"`, name)
+	r.Equal(`"user"`, snowflake.QualifiedObjectName("", "", "user"))
+
+	b := snowflake.ObjectParameter(snowflake.TableType, name, "data_retention_time_in_days")
+	r.Equal(`ALTER TABLE "db"."schema"."table" SET DATA_RETENTION_TIME_IN_DAYS = 30`, b.Set("30"))
+	r.Equal(`ALTER TABLE "db"."schema"."table" UNSET DATA_RETENTION_TIME_IN_DAYS`, b.Unset())
+	r.Equal(`SHOW PARAMETERS LIKE 'DATA_RETENTION_TIME_IN_DAYS' IN TABLE "db"."schema"."table"`, b.Show())
+}
+
+func TestObjectParameters(t *testing.T) {
+	r := require.New(t)
+
+	r.Contains(snowflake.ObjectParameters[snowflake.UserType], "TIMEZONE")
+	r.Contains(snowflake.ObjectParameters[snowflake.UserType], "NETWORK_POLICY")
+	r.Contains(snowflake.ObjectParameters[snowflake.DatabaseType], "MAX_DATA_EXTENSION_TIME_IN_DAYS")
+	r.NotContains(snowflake.ObjectParameters[snowflake.DatabaseType], "TIMEZONE")
+	r.NotContains(snowflake.ObjectParameters[snowflake.TableType], "DATA_RETENTION_TIME_IN_DAYS")
+	r.NotContains(snowflake.ObjectParameters, snowflake.WarehouseType)
+	r.Equal(snowflake.ParameterTypeNumber, snowflake.ObjectParameters[snowflake.UserType]["STATEMENT_TIMEOUT_IN_SECONDS"].Type)
+}
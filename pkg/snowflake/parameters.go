@@ -0,0 +1,213 @@
+package snowflake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// parameter is a go representation of a row returned by SHOW PARAMETERS that
+// can be used in conjunction with github.com/jmoiron/sqlx
+type parameter struct {
+	Key          string `db:"key"`
+	Value        string `db:"value"`
+	DefaultValue string `db:"default"`
+	Level        string `db:"level"`
+	Description  string `db:"description"`
+	Type         string `db:"type"`
+}
+
+// IsSetOn reports whether the parameter was set explicitly at level (e.g.
+// WAREHOUSE) rather than inherited from the account or left at its default.
+func (p *parameter) IsSetOn(level EntityType) bool {
+	return p.Level == string(level)
+}
+
+// ScanParameters turns the rows of a SHOW PARAMETERS query into parameters
+func ScanParameters(rows *sqlx.Rows) ([]*parameter, error) {
+	params := []*parameter{}
+	for rows.Next() {
+		p := &parameter{}
+		if err := rows.StructScan(p); err != nil {
+			return nil, err
+		}
+		params = append(params, p)
+	}
+	return params, rows.Err()
+}
+
+// ParameterType is the type of the value of a parameter.
+type ParameterType string
+
+const (
+	ParameterTypeBoolean ParameterType = "BOOLEAN"
+	ParameterTypeNumber  ParameterType = "NUMBER"
+	ParameterTypeString  ParameterType = "STRING"
+	ParameterTypeEnum    ParameterType = "ENUM"
+)
+
+// ParameterDefinition describes the values a parameter accepts.
+type ParameterDefinition struct {
+	Type ParameterType
+	// Values lists the accepted values of ENUM parameters.
+	Values []string
+}
+
+func boolParameter() ParameterDefinition   { return ParameterDefinition{Type: ParameterTypeBoolean} }
+func numberParameter() ParameterDefinition { return ParameterDefinition{Type: ParameterTypeNumber} }
+func stringParameter() ParameterDefinition { return ParameterDefinition{Type: ParameterTypeString} }
+func enumParameter(values ...string) ParameterDefinition {
+	return ParameterDefinition{Type: ParameterTypeEnum, Values: values}
+}
+
+var accountOnlyParameters = map[string]ParameterDefinition{
+	"ALLOW_CLIENT_MFA_CACHING":                            boolParameter(),
+	"ALLOW_ID_TOKEN":                                      boolParameter(),
+	"CLIENT_ENCRYPTION_KEY_SIZE":                          enumParameter("128", "256"),
+	"ENABLE_INTERNAL_STAGES_PRIVATELINK":                  boolParameter(),
+	"EXTERNAL_OAUTH_ADD_PRIVILEGED_ROLES_TO_BLOCKED_LIST": boolParameter(),
+	"MIN_DATA_RETENTION_TIME_IN_DAYS":                     numberParameter(),
+	"NETWORK_POLICY":                                      stringParameter(),
+	"PERIODIC_DATA_REKEYING":                              boolParameter(),
+	"PREVENT_UNLOAD_TO_INLINE_URL":                        boolParameter(),
+	"PREVENT_UNLOAD_TO_INTERNAL_STAGES":                   boolParameter(),
+	"REQUIRE_STORAGE_INTEGRATION_FOR_STAGE_CREATION":      boolParameter(),
+	"REQUIRE_STORAGE_INTEGRATION_FOR_STAGE_OPERATION":     boolParameter(),
+	"SAML_IDENTITY_PROVIDER":                              stringParameter(),
+	"SSO_LOGIN_PAGE":                                      boolParameter(),
+}
+
+var sessionParameters = map[string]ParameterDefinition{
+	"ABORT_DETACHED_QUERY":                boolParameter(),
+	"AUTOCOMMIT":                          boolParameter(),
+	"BINARY_INPUT_FORMAT":                 enumParameter("HEX", "BASE64", "UTF8"),
+	"BINARY_OUTPUT_FORMAT":                enumParameter("HEX", "BASE64"),
+	"DATE_INPUT_FORMAT":                   stringParameter(),
+	"DATE_OUTPUT_FORMAT":                  stringParameter(),
+	"ERROR_ON_NONDETERMINISTIC_MERGE":     boolParameter(),
+	"ERROR_ON_NONDETERMINISTIC_UPDATE":    boolParameter(),
+	"GEOGRAPHY_OUTPUT_FORMAT":             enumParameter("GEOJSON", "WKT", "WKB", "EWKT", "EWKB"),
+	"JSON_INDENT":                         numberParameter(),
+	"LOCK_TIMEOUT":                        numberParameter(),
+	"QUERY_TAG":                           stringParameter(),
+	"QUOTED_IDENTIFIERS_IGNORE_CASE":      boolParameter(),
+	"ROWS_PER_RESULTSET":                  numberParameter(),
+	"STATEMENT_QUEUED_TIMEOUT_IN_SECONDS": numberParameter(),
+	"STATEMENT_TIMEOUT_IN_SECONDS":        numberParameter(),
+	"TIME_INPUT_FORMAT":                   stringParameter(),
+	"TIME_OUTPUT_FORMAT":                  stringParameter(),
+	"TIMESTAMP_INPUT_FORMAT":              stringParameter(),
+	"TIMESTAMP_LTZ_OUTPUT_FORMAT":         stringParameter(),
+	"TIMESTAMP_NTZ_OUTPUT_FORMAT":         stringParameter(),
+	"TIMESTAMP_OUTPUT_FORMAT":             stringParameter(),
+	"TIMESTAMP_TYPE_MAPPING":              enumParameter("TIMESTAMP_LTZ", "TIMESTAMP_NTZ", "TIMESTAMP_TZ"),
+	"TIMESTAMP_TZ_OUTPUT_FORMAT":          stringParameter(),
+	"TIMEZONE":                            stringParameter(),
+	"TRANSACTION_DEFAULT_ISOLATION_LEVEL": enumParameter("READ COMMITTED"),
+	"TWO_DIGIT_CENTURY_START":             numberParameter(),
+	"UNSUPPORTED_DDL_ACTION":              enumParameter("IGNORE", "FAIL"),
+	"USE_CACHED_RESULT":                   boolParameter(),
+	"WEEK_OF_YEAR_POLICY":                 numberParameter(),
+	"WEEK_START":                          numberParameter(),
+}
+
+var objectParameters = map[string]ParameterDefinition{
+	"DATA_RETENTION_TIME_IN_DAYS":     numberParameter(),
+	"DEFAULT_DDL_COLLATION":           stringParameter(),
+	"MAX_CONCURRENCY_LEVEL":           numberParameter(),
+	"MAX_DATA_EXTENSION_TIME_IN_DAYS": numberParameter(),
+	"PIPE_EXECUTION_PAUSED":           boolParameter(),
+}
+
+// AccountParameters lists the parameters that can be set at account level:
+// account parameters and the account-wide defaults of session and object
+// parameters.
+var AccountParameters = mergeParameters(accountOnlyParameters, sessionParameters, objectParameters)
+
+// ObjectParameters lists the parameters that can be set on each type of object.
+// Parameters the resource of the object already manages, such as
+// DATA_RETENTION_TIME_IN_DAYS or the parameters of warehouses, are left out so
+// that two resources don't keep overwriting each other.
+var ObjectParameters = map[EntityType]map[string]ParameterDefinition{
+	DatabaseType: pickParameters(objectParameters, "MAX_DATA_EXTENSION_TIME_IN_DAYS", "DEFAULT_DDL_COLLATION"),
+	SchemaType:   pickParameters(objectParameters, "MAX_DATA_EXTENSION_TIME_IN_DAYS", "DEFAULT_DDL_COLLATION", "PIPE_EXECUTION_PAUSED"),
+	TableType:    pickParameters(objectParameters, "MAX_DATA_EXTENSION_TIME_IN_DAYS", "DEFAULT_DDL_COLLATION"),
+	UserType:     mergeParameters(sessionParameters, pickParameters(accountOnlyParameters, "NETWORK_POLICY")),
+}
+
+func mergeParameters(parameters ...map[string]ParameterDefinition) map[string]ParameterDefinition {
+	merged := map[string]ParameterDefinition{}
+	for _, m := range parameters {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func pickParameters(parameters map[string]ParameterDefinition, keys ...string) map[string]ParameterDefinition {
+	picked := map[string]ParameterDefinition{}
+	for _, k := range keys {
+		picked[k] = parameters[k]
+	}
+	return picked
+}
+
+// Validate returns an error if value is not a valid value of the parameter.
+func (p ParameterDefinition) Validate(value string) error {
+	switch p.Type {
+	case ParameterTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected a boolean, got %q", value)
+		}
+	case ParameterTypeNumber:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("expected an integer, got %q", value)
+		}
+	case ParameterTypeEnum:
+		for _, v := range p.Values {
+			if strings.EqualFold(v, value) {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected one of %v, got %q", strings.Join(p.Values, ", "), value)
+	}
+	return nil
+}
+
+// Literal returns value as a SQL literal: strings and enums are quoted,
+// booleans and numbers are not. Booleans are spelled true or false whatever
+// form strconv.ParseBool accepted, e.g. 1 or T.
+func (p ParameterDefinition) Literal(value string) string {
+	switch p.Type {
+	case ParameterTypeBoolean:
+		return normalizeBool(value)
+	case ParameterTypeNumber:
+		return value
+	}
+	return fmt.Sprintf(`'%v'`, EscapeString(value))
+}
+
+// Equal reports whether a and b are the same value of the parameter, e.g.
+// "true", "TRUE" and "1" for a boolean.
+func (p ParameterDefinition) Equal(a, b string) bool {
+	switch p.Type {
+	case ParameterTypeBoolean:
+		return strings.EqualFold(normalizeBool(a), normalizeBool(b))
+	case ParameterTypeEnum:
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
+
+// normalizeBool returns the boolean value as true or false, or value itself
+// when it isn't a boolean
+func normalizeBool(value string) string {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return value
+	}
+	return strconv.FormatBool(b)
+}
@@ -0,0 +1,35 @@
+package snowflake
+
+import "regexp"
+
+const redacted = "****"
+
+// quotedOrBare matches a single-quoted literal (honoring the escapes produced by
+// EscapeString) or a bare token up to the next whitespace, comma or paren.
+const quotedOrBare = `(?:'(?:[^'\\]|\\.)*'|[^\s,()]+)`
+
+var (
+	// credentialsRegexp matches a whole CREDENTIALS = (...) clause, including quoted
+	// values that contain parentheses.
+	credentialsRegexp = regexp.MustCompile(`(?i)\b(CREDENTIALS\s*=\s*)\((?:'(?:[^'\\]|\\.)*'|[^)'])*\)`)
+
+	// secretPropertyRegexp matches KEY = value pairs whose value is a secret.
+	secretPropertyRegexp = regexp.MustCompile(`(?i)\b((?:ADMIN_)?PASSWORD|AWS_SECRET_KEY|AWS_TOKEN|AZURE_SAS_TOKEN|MASTER_KEY|OAUTH_CLIENT_SECRET|OAUTH_REFRESH_TOKEN|PRIVATE_KEY)(\s*=\s*)` + quotedOrBare)
+)
+
+// RedactSecrets masks passwords, stage credentials and other secret literals in
+// a SQL statement so that it can be safely written to the debug log. It is not a
+// SQL parser; statements are expected to come from the builders in this package.
+func RedactSecrets(stmt string) string {
+	out := credentialsRegexp.ReplaceAllString(stmt, "${1}("+redacted+")")
+	return secretPropertyRegexp.ReplaceAllString(out, "${1}${2}'"+redacted+"'")
+}
+
+// redactSecretsList applies RedactSecrets to each statement in stmts.
+func redactSecretsList(stmts []string) []string {
+	out := make([]string, len(stmts))
+	for i, s := range stmts {
+		out[i] = RedactSecrets(s)
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			"no secrets",
+			`CREATE WAREHOUSE "wh" COMMENT='password is not here'`,
+			`CREATE WAREHOUSE "wh" COMMENT='password is not here'`,
+		},
+		{
+			"user password",
+			`CREATE USER "u" COMMENT='c' PASSWORD='hunter2' MUST_CHANGE_PASSWORD=true`,
+			`CREATE USER "u" COMMENT='c' PASSWORD='****' MUST_CHANGE_PASSWORD=true`,
+		},
+		{
+			"escaped password",
+			`ALTER USER "u" SET PASSWORD='it\'s a \\ secret'`,
+			`ALTER USER "u" SET PASSWORD='****'`,
+		},
+		{
+			"managed account admin password",
+			`CREATE MANAGED ACCOUNT "a" ADMIN_NAME='admin' ADMIN_PASSWORD='Sup3rS3cret' TYPE=READER`,
+			`CREATE MANAGED ACCOUNT "a" ADMIN_NAME='admin' ADMIN_PASSWORD='****' TYPE=READER`,
+		},
+		{
+			"lower case key with spaces",
+			`alter user "u" set password = 'hunter2'`,
+			`alter user "u" set password = '****'`,
+		},
+		{
+			"stage credentials",
+			`CREATE STAGE "db"."sch"."st" URL = 's3://bucket/' CREDENTIALS = (AWS_KEY_ID='AKIA' AWS_SECRET_KEY='abc)def') COMMENT = 'c'`,
+			`CREATE STAGE "db"."sch"."st" URL = 's3://bucket/' CREDENTIALS = (****) COMMENT = 'c'`,
+		},
+		{
+			"alter stage credentials",
+			`ALTER STAGE "db"."sch"."st" SET CREDENTIALS = (AZURE_SAS_TOKEN='?sv=2020&sig=abc')`,
+			`ALTER STAGE "db"."sch"."st" SET CREDENTIALS = (****)`,
+		},
+		{
+			"bare secret key outside of credentials",
+			`COPY INTO t FROM 's3://b' AWS_SECRET_KEY='abc' AWS_TOKEN=xyz`,
+			`COPY INTO t FROM 's3://b' AWS_SECRET_KEY='****' AWS_TOKEN='****'`,
+		},
+		{
+			"encryption master key",
+			`ALTER STAGE "db"."sch"."st" SET ENCRYPTION = (TYPE='AWS_CSE' MASTER_KEY='c2VjcmV0')`,
+			`ALTER STAGE "db"."sch"."st" SET ENCRYPTION = (TYPE='AWS_CSE' MASTER_KEY='****')`,
+		},
+		{
+			"azure sas token",
+			`CREATE NOTIFICATION INTEGRATION "n" AZURE_SAS_TOKEN='?sv=1' ENABLED=true`,
+			`CREATE NOTIFICATION INTEGRATION "n" AZURE_SAS_TOKEN='****' ENABLED=true`,
+		},
+		{
+			"oauth client secret",
+			`ALTER SECURITY INTEGRATION "i" SET OAUTH_CLIENT_SECRET='s3cr3t'`,
+			`ALTER SECURITY INTEGRATION "i" SET OAUTH_CLIENT_SECRET='****'`,
+		},
+		{
+			"instrumentedsql key values",
+			`[DEBUG] sql-conn-exec [query CREATE USER "u" PASSWORD='hunter2' args {}]`,
+			`[DEBUG] sql-conn-exec [query CREATE USER "u" PASSWORD='****' args {}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			r.Equal(tt.want, snowflake.RedactSecrets(tt.input))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package snowflake
 
 import (
 	"database/sql"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -16,6 +17,7 @@ func Role(name string) *Builder {
 type role struct {
 	Name    sql.NullString `db:"name"`
 	Comment sql.NullString `db:"comment"`
+	Owner   sql.NullString `db:"owner"`
 }
 
 func ScanRole(row *sqlx.Row) (*role, error) {
@@ -23,3 +25,15 @@ func ScanRole(row *sqlx.Row) (*role, error) {
 	err := row.StructScan(r)
 	return r, err
 }
+
+// ScanRoles turns the rows of a SHOW ROLES query into roles
+func ScanRoles(rows *sqlx.Rows) ([]role, error) {
+	roles := []role{}
+	err := sqlx.StructScan(rows, &roles)
+	return roles, err
+}
+
+// UseRole returns the statement that switches the session's primary role.
+func UseRole(name string) string {
+	return fmt.Sprintf(`USE ROLE "%s"`, name)
+}
@@ -1,6 +1,11 @@
 package snowflake
 
-import "fmt"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
 
 type RoleGrantBuilder struct {
 	name string
@@ -39,3 +44,63 @@ func (gr *RoleGrantExecutable) Grant() string {
 func (gr *RoleGrantExecutable) Revoke() string {
 	return fmt.Sprintf(`REVOKE ROLE "%s" FROM %s "%s"`, gr.name, gr.granteeType, gr.grantee) // nolint: gosec
 }
+
+// roleGrantee is a row of SHOW GRANTS OF ROLE: a user or role the role has
+// been granted to
+type roleGrantee struct {
+	CreatedOn   sql.RawBytes   `db:"created_on"`
+	Role        sql.NullString `db:"role"`
+	GrantedTo   sql.NullString `db:"granted_to"`
+	GranteeName sql.NullString `db:"grantee_name"`
+	Grantedby   sql.NullString `db:"granted_by"`
+}
+
+// ListRoleGrantees returns the users and roles to which role has been granted
+func ListRoleGrantees(ctx context.Context, db *sql.DB, role string) ([]*roleGrantee, error) {
+	rows, err := Query(ctx, db, ShowGrantsOfRole(role))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grantees := make([]*roleGrantee, 0)
+	for rows.Next() {
+		g := &roleGrantee{}
+		err = rows.StructScan(g)
+		if err != nil {
+			return nil, err
+		}
+		if g.GranteeName.Valid {
+			g.GranteeName.String = unquote(g.GranteeName.String)
+		}
+		grantees = append(grantees, g)
+	}
+	return grantees, rows.Err()
+}
+
+// ListGrantedRoles returns the names of the roles that have been granted to
+// role
+func ListGrantedRoles(ctx context.Context, db *sql.DB, role string) ([]string, error) {
+	rows, err := Query(ctx, db, ShowGrantsToRole(role))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]string, 0)
+	for rows.Next() {
+		g := &CurrentGrant{}
+		err = rows.StructScan(g)
+		if err != nil {
+			return nil, err
+		}
+		if g.GrantType == string(roleType) && g.Privilege == "USAGE" {
+			roles = append(roles, unquote(g.GrantName))
+		}
+	}
+	return roles, rows.Err()
+}
+
+func unquote(s string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(s, `"`), `"`)
+}
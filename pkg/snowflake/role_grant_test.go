@@ -1,9 +1,15 @@
 package snowflake_test
 
 import (
+	"context"
+	"database/sql"
 	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 
 	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +30,36 @@ func TestRoleGrant(t *testing.T) {
 	r.Equal(`REVOKE ROLE "role1" FROM ROLE "role2"`, r2)
 
 }
+
+func TestListRoleGrantees(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}).
+			AddRow("_", "foo", "ROLE", "bam", "").
+			AddRow("_", "foo", "USER", `"bob"`, "")
+		mock.ExpectQuery(`SHOW GRANTS OF ROLE "foo"`).WillReturnRows(rows)
+		read, err := snowflake.ListRoleGrantees(context.Background(), db, "foo")
+		r.NoError(err)
+		r.Len(read, 2)
+		r.Equal("ROLE", read[0].GrantedTo.String)
+		r.Equal("bam", read[0].GranteeName.String)
+		r.Equal("USER", read[1].GrantedTo.String)
+		r.Equal("bob", read[1].GranteeName.String)
+	})
+}
+
+func TestListGrantedRoles(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"}).
+			AddRow(time.Time{}, "USAGE", "ROLE", "bar", "ROLE", "foo", false, "SECURITYADMIN").
+			AddRow(time.Time{}, "USAGE", "WAREHOUSE", "wh", "ROLE", "foo", false, "SYSADMIN").
+			AddRow(time.Time{}, "OWNERSHIP", "ROLE", "baz", "ROLE", "foo", false, "SECURITYADMIN")
+		mock.ExpectQuery(`SHOW GRANTS TO ROLE "foo"`).WillReturnRows(rows)
+		roles, err := snowflake.ListGrantedRoles(context.Background(), db, "foo")
+		r.NoError(err)
+		r.Equal([]string{"bar"}, roles)
+	})
+}
@@ -109,7 +109,7 @@ func (sb *SchemaBuilder) Create() string {
 
 // Rename returns the SQL query that will rename the schema.
 func (sb *SchemaBuilder) Rename(newName string) string {
-	return fmt.Sprintf(`ALTER SCHEMA %v RENAME TO "%v"`, sb.QualifiedName(), newName)
+	return fmt.Sprintf(`ALTER SCHEMA %v RENAME TO %v`, sb.QualifiedName(), Schema(newName).WithDB(sb.db).QualifiedName())
 }
 
 // Swap returns the SQL query that Swaps all objects (tables, views, etc.) and
@@ -177,8 +177,10 @@ func (sb *SchemaBuilder) Show() string {
 }
 
 type schema struct {
+	CreatedOn     sql.NullString `db:"created_on"`
 	Name          sql.NullString `db:"name"`
 	DatabaseName  sql.NullString `db:"database_name"`
+	Owner         sql.NullString `db:"owner"`
 	Comment       sql.NullString `db:"comment"`
 	Options       sql.NullString `db:"options"`
 	RetentionTime sql.NullInt64  `db:"retention_time"`
@@ -189,3 +191,10 @@ func ScanSchema(row *sqlx.Row) (*schema, error) {
 	err := row.StructScan(r)
 	return r, err
 }
+
+// ScanSchemas turns the rows of a SHOW SCHEMAS query into schemas
+func ScanSchemas(rows *sqlx.Rows) ([]schema, error) {
+	schemas := []schema{}
+	err := sqlx.StructScan(rows, &schemas)
+	return schemas, err
+}
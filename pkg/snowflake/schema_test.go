@@ -33,6 +33,9 @@ func TestSchemaRename(t *testing.T) {
 	r := require.New(t)
 	s := Schema("test")
 	r.Equal(s.Rename("bob"), `ALTER SCHEMA "test" RENAME TO "bob"`)
+
+	s.WithDB("db")
+	r.Equal(s.Rename("bob"), `ALTER SCHEMA "db"."test" RENAME TO "db"."bob"`)
 }
 
 func TestSchemaSwap(t *testing.T) {
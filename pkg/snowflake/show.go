@@ -0,0 +1,75 @@
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ShowBuilder abstracts the creation of SHOW queries that list every object of
+// a kind, optionally filtered by pattern and scoped to a container.
+type ShowBuilder struct {
+	objectType string
+	like       string
+	in         string
+	startsWith string
+}
+
+// Show returns a pointer to a ShowBuilder listing objects of the given plural
+// type, e.g. "TABLES".
+func Show(objectType string) *ShowBuilder {
+	return &ShowBuilder{objectType: objectType}
+}
+
+// Like adds a case-insensitive LIKE filter, e.g. "%prod%".
+func (b *ShowBuilder) Like(pattern string) *ShowBuilder {
+	b.like = pattern
+	return b
+}
+
+// StartsWith adds a case-sensitive STARTS WITH filter.
+func (b *ShowBuilder) StartsWith(prefix string) *ShowBuilder {
+	b.startsWith = prefix
+	return b
+}
+
+// InDatabase limits the objects to the ones in database db.
+func (b *ShowBuilder) InDatabase(db string) *ShowBuilder {
+	b.in = fmt.Sprintf(`DATABASE "%v"`, db)
+	return b
+}
+
+// InSchema limits the objects to the ones in schema db.schema.
+func (b *ShowBuilder) InSchema(db, schema string) *ShowBuilder {
+	b.in = fmt.Sprintf(`SCHEMA "%v"."%v"`, db, schema)
+	return b
+}
+
+// InAccount lists the objects at account level, e.g. account parameters.
+func (b *ShowBuilder) InAccount() *ShowBuilder {
+	b.in = "ACCOUNT"
+	return b
+}
+
+// In limits the objects to the ones in or of the object name of type
+// objectType. name is used as is, so it must be quoted where needed.
+func (b *ShowBuilder) In(objectType, name string) *ShowBuilder {
+	b.in = fmt.Sprintf(`%v %v`, strings.ToUpper(objectType), name)
+	return b
+}
+
+// Statement returns the SHOW query.
+func (b *ShowBuilder) Statement() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`SHOW %v`, b.objectType))
+
+	if b.like != "" {
+		q.WriteString(fmt.Sprintf(` LIKE '%v'`, EscapeString(b.like)))
+	}
+	if b.in != "" {
+		q.WriteString(fmt.Sprintf(` IN %v`, b.in))
+	}
+	if b.startsWith != "" {
+		q.WriteString(fmt.Sprintf(` STARTS WITH '%v'`, EscapeString(b.startsWith)))
+	}
+	return q.String()
+}
@@ -0,0 +1,23 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShow(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(`SHOW DATABASES`, snowflake.Show("DATABASES").Statement())
+	r.Equal(`SHOW DATABASES LIKE 'prod%'`, snowflake.Show("DATABASES").Like("prod%").Statement())
+	r.Equal(
+		`SHOW SCHEMAS LIKE '%raw%' IN DATABASE "db" STARTS WITH 'RAW'`,
+		snowflake.Show("SCHEMAS").Like("%raw%").StartsWith("RAW").InDatabase("db").Statement(),
+	)
+	r.Equal(`SHOW TABLES IN SCHEMA "db"."s"`, snowflake.Show("TABLES").InSchema("db", "s").Statement())
+	r.Equal(`SHOW VIEWS LIKE 'it\'s' IN SCHEMA "db"."s"`, snowflake.Show("VIEWS").Like("it's").InSchema("db", "s").Statement())
+	r.Equal(`SHOW PARAMETERS LIKE '%TIMEOUT%' IN ACCOUNT`, snowflake.Show("PARAMETERS").Like("%TIMEOUT%").InAccount().Statement())
+	r.Equal(`SHOW PARAMETERS IN TABLE "db"."s"."t"`, snowflake.Show("PARAMETERS").In("table", `"db"."s"."t"`).Statement())
+}
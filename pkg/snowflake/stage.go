@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -135,7 +136,7 @@ func (sb *StageBuilder) Create() string {
 
 // Rename returns the SQL query that will rename the stage.
 func (sb *StageBuilder) Rename(newName string) string {
-	return fmt.Sprintf(`ALTER STAGE %v RENAME TO "%v"`, sb.QualifiedName(), newName)
+	return fmt.Sprintf(`ALTER STAGE %v RENAME TO %v`, sb.QualifiedName(), Stage(newName, sb.db, sb.schema).QualifiedName())
 }
 
 // ChangeComment returns the SQL query that will update the comment on the stage.
@@ -198,6 +199,16 @@ func (sb *StageBuilder) Show() string {
 	return fmt.Sprintf(`SHOW STAGES LIKE '%v' IN SCHEMA "%v"."%v"`, sb.name, sb.db, sb.schema)
 }
 
+// List returns the SQL query that will list the files in the stage, only
+// keeping the ones whose path matches pattern if it is set.
+func (sb *StageBuilder) List(pattern string) string {
+	q := fmt.Sprintf(`LIST @%v`, sb.QualifiedName())
+	if pattern != "" {
+		q += fmt.Sprintf(` PATTERN = '%v'`, EscapeString(pattern))
+	}
+	return q
+}
+
 type stage struct {
 	Name               *string `db:"name"`
 	DatabaseName       *string `db:"database_name"`
@@ -227,11 +238,11 @@ type descStageRow struct {
 	PropertyDefault string `db:"property_default"`
 }
 
-func DescStage(db *sql.DB, query string) (*descStageResult, error) {
+func DescStage(ctx context.Context, db *sql.DB, query string) (*descStageResult, error) {
 	r := &descStageResult{}
 	var ff []string
 	var co []string
-	rows, err := Query(db, query)
+	rows, err := Query(ctx, db, query)
 	if err != nil {
 		return r, err
 	}
@@ -269,3 +280,17 @@ func DescStage(db *sql.DB, query string) (*descStageResult, error) {
 	r.CopyOptions = strings.Join(co, " ")
 	return r, nil
 }
+
+type stageFile struct {
+	Name         string         `db:"name"`
+	Size         int64          `db:"size"`
+	MD5          sql.NullString `db:"md5"`
+	LastModified string         `db:"last_modified"`
+}
+
+// ScanStageFiles turns the rows of a LIST query into stage files
+func ScanStageFiles(rows *sqlx.Rows) ([]stageFile, error) {
+	files := []stageFile{}
+	err := sqlx.StructScan(rows, &files)
+	return files, err
+}
@@ -38,7 +38,7 @@ func TestStageCreate(t *testing.T) {
 func TestStageRename(t *testing.T) {
 	r := require.New(t)
 	s := Stage("test_stage", "test_db", "test_schema")
-	r.Equal(s.Rename("test_stage2"), `ALTER STAGE "test_db"."test_schema"."test_stage" RENAME TO "test_stage2"`)
+	r.Equal(s.Rename("test_stage2"), `ALTER STAGE "test_db"."test_schema"."test_stage" RENAME TO "test_db"."test_schema"."test_stage2"`)
 }
 
 func TestStageChangeComment(t *testing.T) {
@@ -112,3 +112,10 @@ func TestStageShow(t *testing.T) {
 	s := Stage("test_stage", "test_db", "test_schema")
 	r.Equal(s.Show(), `SHOW STAGES LIKE 'test_stage' IN SCHEMA "test_db"."test_schema"`)
 }
+
+func TestStageList(t *testing.T) {
+	r := require.New(t)
+	s := Stage("test_stage", "test_db", "test_schema")
+	r.Equal(`LIST @"test_db"."test_schema"."test_stage"`, s.List(""))
+	r.Equal(`LIST @"test_db"."test_schema"."test_stage" PATTERN = '.*\\.csv'`, s.List(`.*\.csv`))
+}
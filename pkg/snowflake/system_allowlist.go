@@ -0,0 +1,56 @@
+package snowflake
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SystemAllowlistBuilder abstracts calling the SYSTEM$ALLOWLIST system
+// function and its older SYSTEM$WHITELIST name
+type SystemAllowlistBuilder struct {
+	function string
+}
+
+// SystemAllowlist returns a pointer to a builder that abstracts calling the SYSTEM$ALLOWLIST system function
+func SystemAllowlist() *SystemAllowlistBuilder {
+	return &SystemAllowlistBuilder{function: "SYSTEM$ALLOWLIST"}
+}
+
+// SystemWhitelist returns a pointer to a builder that abstracts calling the SYSTEM$WHITELIST system function,
+// which accounts that predate SYSTEM$ALLOWLIST still use
+func SystemWhitelist() *SystemAllowlistBuilder {
+	return &SystemAllowlistBuilder{function: "SYSTEM$WHITELIST"}
+}
+
+// Select generates the select statement for obtaining the allowlist
+func (pb *SystemAllowlistBuilder) Select() string {
+	return fmt.Sprintf(`SELECT %v() AS "allowlist"`, pb.function)
+}
+
+type allowlistRaw struct {
+	Allowlist string `db:"allowlist"`
+}
+
+// AllowlistEntry is a host and port the clients of an account must be able to
+// reach
+type AllowlistEntry struct {
+	Type string `json:"type"`
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+// ScanAllowlist converts a result into an allowlistRaw
+func ScanAllowlist(row *sqlx.Row) (*allowlistRaw, error) {
+	allowlist := &allowlistRaw{}
+	err := row.StructScan(allowlist)
+	return allowlist, err
+}
+
+// GetStructuredConfig parses the JSON returned by Snowflake
+func (r *allowlistRaw) GetStructuredConfig() ([]AllowlistEntry, error) {
+	entries := []AllowlistEntry{}
+	err := json.Unmarshal([]byte(r.Allowlist), &entries)
+	return entries, err
+}
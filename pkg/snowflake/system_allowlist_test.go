@@ -0,0 +1,28 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemAllowlist(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(SystemAllowlist().Select(), `SELECT SYSTEM$ALLOWLIST() AS "allowlist"`)
+	r.Equal(SystemWhitelist().Select(), `SELECT SYSTEM$WHITELIST() AS "allowlist"`)
+}
+
+func TestSystemAllowlistGetStructuredConfig(t *testing.T) {
+	r := require.New(t)
+
+	raw := &allowlistRaw{
+		Allowlist: `[{"type":"SNOWFLAKE_DEPLOYMENT","host":"ab1234.snowflakecomputing.com","port":443},{"type":"OCSP_CACHE","host":"ocsp.snowflakecomputing.com","port":80}]`,
+	}
+	entries, e := raw.GetStructuredConfig()
+	r.Nil(e)
+	r.Equal([]AllowlistEntry{
+		{Type: "SNOWFLAKE_DEPLOYMENT", Host: "ab1234.snowflakecomputing.com", Port: 443},
+		{Type: "OCSP_CACHE", Host: "ocsp.snowflakecomputing.com", Port: 80},
+	}, entries)
+}
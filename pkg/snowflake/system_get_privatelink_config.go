@@ -0,0 +1,66 @@
+package snowflake
+
+import (
+	"encoding/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SystemGetPrivateLinkConfigBuilder abstracts calling the SYSTEM$GET_PRIVATELINK_CONFIG system function
+type SystemGetPrivateLinkConfigBuilder struct{}
+
+// SystemGetPrivateLinkConfig returns a pointer to a builder that abstracts calling the SYSTEM$GET_PRIVATELINK_CONFIG system function
+func SystemGetPrivateLinkConfig() *SystemGetPrivateLinkConfigBuilder {
+	return &SystemGetPrivateLinkConfigBuilder{}
+}
+
+// Select generates the select statement for obtaining the privatelink config
+func (pb *SystemGetPrivateLinkConfigBuilder) Select() string {
+	return `SELECT SYSTEM$GET_PRIVATELINK_CONFIG() AS "config"`
+}
+
+type privateLinkConfigRaw struct {
+	Config string `db:"config"`
+}
+
+type privateLinkConfigInternal struct {
+	AccountName      string `json:"privatelink-account-name"`
+	AwsVpceID        string `json:"privatelink-vpce-id,omitempty"`
+	AzurePrivateLink string `json:"privatelink-pls-id,omitempty"`
+	AccountURL       string `json:"privatelink-account-url"`
+	OCSPURL          string `json:"privatelink_ocsp-url"`
+}
+
+// PrivateLinkConfig is the parsed result of SYSTEM$GET_PRIVATELINK_CONFIG.
+// Only the endpoint of the account's cloud is set.
+type PrivateLinkConfig struct {
+	AccountName               string
+	AwsVpceID                 string
+	AzurePrivateLinkServiceID string
+	AccountURL                string
+	OCSPURL                   string
+}
+
+// ScanPrivateLinkConfig converts a result into a privateLinkConfigRaw
+func ScanPrivateLinkConfig(row *sqlx.Row) (*privateLinkConfigRaw, error) {
+	config := &privateLinkConfigRaw{}
+	err := row.StructScan(config)
+	return config, err
+}
+
+// GetStructuredConfig parses the JSON returned by Snowflake
+func (r *privateLinkConfigRaw) GetStructuredConfig() (*PrivateLinkConfig, error) {
+	config := &privateLinkConfigInternal{}
+	err := json.Unmarshal([]byte(r.Config), config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateLinkConfig{
+		AccountName:               config.AccountName,
+		AwsVpceID:                 config.AwsVpceID,
+		AzurePrivateLinkServiceID: config.AzurePrivateLink,
+		AccountURL:                config.AccountURL,
+		OCSPURL:                   config.OCSPURL,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemGetPrivateLinkConfig(t *testing.T) {
+	r := require.New(t)
+	sb := SystemGetPrivateLinkConfig()
+
+	r.Equal(sb.Select(), `SELECT SYSTEM$GET_PRIVATELINK_CONFIG() AS "config"`)
+}
+
+func TestSystemGetPrivateLinkGetStructuredConfigAws(t *testing.T) {
+	r := require.New(t)
+
+	raw := &privateLinkConfigRaw{
+		Config: `{"privatelink-account-name":"ab1234.us-west-2.privatelink","privatelink-vpce-id":"com.amazonaws.vpce.us-west-2.vpce-svc-0123","privatelink-account-url":"ab1234.us-west-2.privatelink.snowflakecomputing.com","privatelink_ocsp-url":"ocsp.ab1234.us-west-2.privatelink.snowflakecomputing.com"}`,
+	}
+	c, e := raw.GetStructuredConfig()
+	r.Nil(e)
+
+	r.Equal("ab1234.us-west-2.privatelink", c.AccountName)
+	r.Equal("com.amazonaws.vpce.us-west-2.vpce-svc-0123", c.AwsVpceID)
+	r.Equal("", c.AzurePrivateLinkServiceID)
+	r.Equal("ab1234.us-west-2.privatelink.snowflakecomputing.com", c.AccountURL)
+	r.Equal("ocsp.ab1234.us-west-2.privatelink.snowflakecomputing.com", c.OCSPURL)
+}
+
+func TestSystemGetPrivateLinkGetStructuredConfigAzure(t *testing.T) {
+	r := require.New(t)
+
+	raw := &privateLinkConfigRaw{
+		Config: `{"privatelink-account-name":"ab1234.east-us-2.privatelink","privatelink-pls-id":"sf-pvlinksvc-azeastus2.east-us-2.azure.privatelinkservice.net","privatelink-account-url":"ab1234.east-us-2.privatelink.snowflakecomputing.com","privatelink_ocsp-url":"ocsp.ab1234.east-us-2.privatelink.snowflakecomputing.com"}`,
+	}
+	c, e := raw.GetStructuredConfig()
+	r.Nil(e)
+
+	r.Equal("", c.AwsVpceID)
+	r.Equal("sf-pvlinksvc-azeastus2.east-us-2.azure.privatelinkservice.net", c.AzurePrivateLinkServiceID)
+}
@@ -0,0 +1,58 @@
+package snowflake
+
+import (
+	"encoding/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SystemGetSnowflakePlatformInfoBuilder abstracts calling the SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO system function
+type SystemGetSnowflakePlatformInfoBuilder struct{}
+
+// SystemGetSnowflakePlatformInfo returns a pointer to a builder that abstracts calling the SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO system function
+func SystemGetSnowflakePlatformInfo() *SystemGetSnowflakePlatformInfoBuilder {
+	return &SystemGetSnowflakePlatformInfoBuilder{}
+}
+
+// Select generates the select statement for obtaining the platform info
+func (pb *SystemGetSnowflakePlatformInfoBuilder) Select() string {
+	return `SELECT SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO() AS "info"`
+}
+
+type snowflakePlatformInfoRaw struct {
+	Info string `db:"info"`
+}
+
+type snowflakePlatformInfoInternal struct {
+	AzureVnetSubnetIds []string `json:"snowflake-vnet-subnet-id,omitempty"`
+	AwsVpcIds          []string `json:"snowflake-vpc-id,omitempty"`
+}
+
+// SnowflakePlatformInfo is the parsed result of
+// SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO. Only the ids of the account's cloud are
+// set.
+type SnowflakePlatformInfo struct {
+	AzureVnetSubnetIds []string
+	AwsVpcIds          []string
+}
+
+// ScanSnowflakePlatformInfo converts a result into a snowflakePlatformInfoRaw
+func ScanSnowflakePlatformInfo(row *sqlx.Row) (*snowflakePlatformInfoRaw, error) {
+	info := &snowflakePlatformInfoRaw{}
+	err := row.StructScan(info)
+	return info, err
+}
+
+// GetStructuredConfig parses the JSON returned by Snowflake
+func (r *snowflakePlatformInfoRaw) GetStructuredConfig() (*SnowflakePlatformInfo, error) {
+	info := &snowflakePlatformInfoInternal{}
+	err := json.Unmarshal([]byte(r.Info), info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnowflakePlatformInfo{
+		AzureVnetSubnetIds: info.AzureVnetSubnetIds,
+		AwsVpcIds:          info.AwsVpcIds,
+	}, nil
+}
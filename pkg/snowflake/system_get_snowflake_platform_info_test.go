@@ -0,0 +1,34 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemGetSnowflakePlatformInfo(t *testing.T) {
+	r := require.New(t)
+	sb := SystemGetSnowflakePlatformInfo()
+
+	r.Equal(sb.Select(), `SELECT SYSTEM$GET_SNOWFLAKE_PLATFORM_INFO() AS "info"`)
+}
+
+func TestSystemGetSnowflakePlatformInfoGetStructuredConfig(t *testing.T) {
+	r := require.New(t)
+
+	raw := &snowflakePlatformInfoRaw{
+		Info: `{"snowflake-vpc-id":["vpc-1","vpc-2"]}`,
+	}
+	c, e := raw.GetStructuredConfig()
+	r.Nil(e)
+	r.Equal([]string{"vpc-1", "vpc-2"}, c.AwsVpcIds)
+	r.Empty(c.AzureVnetSubnetIds)
+
+	raw = &snowflakePlatformInfoRaw{
+		Info: `{"snowflake-vnet-subnet-id":["/subscriptions/1/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/xp"]}`,
+	}
+	c, e = raw.GetStructuredConfig()
+	r.Nil(e)
+	r.Empty(c.AwsVpcIds)
+	r.Equal([]string{"/subscriptions/1/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/xp"}, c.AzureVnetSubnetIds)
+}
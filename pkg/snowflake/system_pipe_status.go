@@ -0,0 +1,59 @@
+package snowflake
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SystemPipeStatusBuilder abstracts calling the SYSTEM$PIPE_STATUS system function
+type SystemPipeStatusBuilder struct {
+	pipe *PipeBuilder
+}
+
+// SystemPipeStatus returns a pointer to a builder that abstracts calling the SYSTEM$PIPE_STATUS system function
+func SystemPipeStatus(name, db, schema string) *SystemPipeStatusBuilder {
+	return &SystemPipeStatusBuilder{
+		pipe: Pipe(name, db, schema),
+	}
+}
+
+// Select generates the select statement for obtaining the pipe status
+func (pb *SystemPipeStatusBuilder) Select() string {
+	return fmt.Sprintf(`SELECT SYSTEM$PIPE_STATUS('%v') AS "status"`, EscapeString(pb.pipe.QualifiedName()))
+}
+
+type pipeStatusRaw struct {
+	Status string `db:"status"`
+}
+
+// PipeStatus is the parsed result of SYSTEM$PIPE_STATUS. Fields Snowflake
+// leaves out, e.g. the notification channel of a pipe without auto ingest,
+// are zero.
+type PipeStatus struct {
+	ExecutionState                  string `json:"executionState"`
+	PendingFileCount                int    `json:"pendingFileCount"`
+	NotificationChannelName         string `json:"notificationChannelName"`
+	NumOutstandingMessagesOnChannel int    `json:"numOutstandingMessagesOnChannel"`
+	LastIngestedTimestamp           string `json:"lastIngestedTimestamp"`
+	LastIngestedFilePath            string `json:"lastIngestedFilePath"`
+	LastReceivedMessageTimestamp    string `json:"lastReceivedMessageTimestamp"`
+	LastForwardedMessageTimestamp   string `json:"lastForwardedMessageTimestamp"`
+	Error                           string `json:"error"`
+	Fault                           string `json:"fault"`
+}
+
+// ScanPipeStatus converts a result into a pipeStatusRaw
+func ScanPipeStatus(row *sqlx.Row) (*pipeStatusRaw, error) {
+	status := &pipeStatusRaw{}
+	err := row.StructScan(status)
+	return status, err
+}
+
+// GetStructuredConfig parses the JSON returned by Snowflake
+func (r *pipeStatusRaw) GetStructuredConfig() (*PipeStatus, error) {
+	status := &PipeStatus{}
+	err := json.Unmarshal([]byte(r.Status), status)
+	return status, err
+}
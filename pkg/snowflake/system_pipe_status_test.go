@@ -0,0 +1,30 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemPipeStatus(t *testing.T) {
+	r := require.New(t)
+	sb := SystemPipeStatus("pipe", "db", "schema")
+
+	r.Equal(sb.Select(), `SELECT SYSTEM$PIPE_STATUS('"db"."schema"."pipe"') AS "status"`)
+}
+
+func TestSystemPipeStatusGetStructuredConfig(t *testing.T) {
+	r := require.New(t)
+
+	raw := &pipeStatusRaw{
+		Status: `{"executionState":"RUNNING","pendingFileCount":2,"notificationChannelName":"arn:aws:sqs:us-west-2:123:sf-snowpipe","numOutstandingMessagesOnChannel":1,"lastReceivedMessageTimestamp":"2021-01-01T00:00:00.000Z"}`,
+	}
+	s, e := raw.GetStructuredConfig()
+	r.Nil(e)
+	r.Equal("RUNNING", s.ExecutionState)
+	r.Equal(2, s.PendingFileCount)
+	r.Equal("arn:aws:sqs:us-west-2:123:sf-snowpipe", s.NotificationChannelName)
+	r.Equal(1, s.NumOutstandingMessagesOnChannel)
+	r.Equal("2021-01-01T00:00:00.000Z", s.LastReceivedMessageTimestamp)
+	r.Equal("", s.LastIngestedFilePath)
+}
@@ -3,14 +3,26 @@ package snowflake
 import (
 	"database/sql"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
 
 type Column struct {
-	name  string
-	_type string // type is reserved
+	name     string
+	_type    string // type is reserved
+	notNull  bool
+	_default string // default is reserved
+	comment  string
+	identity *columnIdentity
+}
+
+// columnIdentity holds the start and step of an identity column
+type columnIdentity struct {
+	startNum int
+	stepNum  int
 }
 
 func (c *Column) WithName(name string) *Column {
@@ -22,15 +34,58 @@ func (c *Column) WithType(t string) *Column {
 	return c
 }
 
+// WithNullable sets whether the column accepts NULL values
+func (c *Column) WithNullable(nullable bool) *Column {
+	c.notNull = !nullable
+	return c
+}
+
+// WithDefault sets the expression used as the column's default value
+func (c *Column) WithDefault(d string) *Column {
+	c._default = d
+	return c
+}
+
+// WithComment adds a comment to the column
+func (c *Column) WithComment(comment string) *Column {
+	c.comment = comment
+	return c
+}
+
+// WithIdentity makes the column an identity (autoincrement) column starting at
+// startNum and incremented by stepNum
+func (c *Column) WithIdentity(startNum, stepNum int) *Column {
+	c.identity = &columnIdentity{startNum: startNum, stepNum: stepNum}
+	return c
+}
+
 func (c *Column) getColumnDefinition() string {
 	if c == nil {
 		return ""
 	}
-	return fmt.Sprintf(`"%v" %v`, EscapeString(c.name), EscapeString(c._type))
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`"%v" %v`, EscapeString(c.name), EscapeString(c._type)))
+
+	if c.identity != nil {
+		q.WriteString(fmt.Sprintf(` IDENTITY(%v, %v)`, c.identity.startNum, c.identity.stepNum))
+	} else if c._default != "" {
+		q.WriteString(fmt.Sprintf(` DEFAULT %v`, c._default))
+	}
+	if c.notNull {
+		q.WriteString(` NOT NULL`)
+	}
+	if c.comment != "" {
+		q.WriteString(fmt.Sprintf(` COMMENT '%v'`, EscapeString(c.comment)))
+	}
+	return q.String()
 }
 
 type Columns []Column
 
+// identityDefault matches the default DESC TABLE reports for identity columns,
+// e.g. IDENTITY START 1 INCREMENT 1
+var identityDefault = regexp.MustCompile(`^(?:IDENTITY|AUTOINCREMENT) START (-?\d+) INCREMENT (-?\d+)`)
+
 // NewColumns generates columns from a table description
 func NewColumns(tds []tableDescription) Columns {
 	cs := []Column{}
@@ -38,10 +93,20 @@ func NewColumns(tds []tableDescription) Columns {
 		if td.Kind.String != "COLUMN" {
 			continue
 		}
-		cs = append(cs, Column{
-			name:  td.Name.String,
-			_type: td.Type.String,
-		})
+		c := Column{
+			name:    td.Name.String,
+			_type:   td.Type.String,
+			notNull: td.Null.String == "N",
+			comment: td.Comment.String,
+		}
+		if m := identityDefault.FindStringSubmatch(td.Default.String); m != nil {
+			startNum, _ := strconv.Atoi(m[1])
+			stepNum, _ := strconv.Atoi(m[2])
+			c.WithIdentity(startNum, stepNum)
+		} else {
+			c._default = td.Default.String
+		}
+		cs = append(cs, c)
 	}
 	return Columns(cs)
 }
@@ -52,18 +117,33 @@ func (c Columns) Flatten() []interface{} {
 		flat := map[string]interface{}{}
 		flat["name"] = col.name
 		flat["type"] = col._type
+		flat["nullable"] = !col.notNull
+		flat["default"] = col._default
+		flat["comment"] = col.comment
+
+		identity := []interface{}{}
+		if col.identity != nil {
+			identity = append(identity, map[string]interface{}{
+				"start_num": col.identity.startNum,
+				"step_num":  col.identity.stepNum,
+			})
+		}
+		flat["identity"] = identity
 
 		flattened = append(flattened, flat)
 	}
 	return flattened
 }
 
-func (c Columns) getColumnDefinitions() string {
+func (c Columns) getColumnDefinitions(constraints ...TableConstraint) string {
 	// TODO(el): verify Snowflake reflects column order back in desc table calls
 	columnDefinitions := []string{}
 	for _, column := range c {
 		columnDefinitions = append(columnDefinitions, column.getColumnDefinition())
 	}
+	for _, constraint := range constraints {
+		columnDefinitions = append(columnDefinitions, constraint.getConstraintDefinition())
+	}
 
 	// NOTE: intentionally blank leading space
 	return fmt.Sprintf(" (%s)", strings.Join(columnDefinitions, ", "))
@@ -71,11 +151,17 @@ func (c Columns) getColumnDefinitions() string {
 
 // TableBuilder abstracts the creation of SQL queries for a Snowflake schema
 type TableBuilder struct {
-	name    string
-	db      string
-	schema  string
-	columns Columns
-	comment string
+	name                 string
+	db                   string
+	schema               string
+	columns              Columns
+	comment              string
+	clusterBy            []string
+	setDataRetentionDays bool
+	dataRetentionDays    int
+	changeTracking       bool
+	constraints          []TableConstraint
+	transient            bool
 }
 
 // QualifiedName prepends the db and schema if set and escapes everything nicely
@@ -105,6 +191,32 @@ func (tb *TableBuilder) WithComment(c string) *TableBuilder {
 	return tb
 }
 
+// Transient adds the TRANSIENT flag to the TableBuilder
+func (tb *TableBuilder) Transient() *TableBuilder {
+	tb.transient = true
+	return tb
+}
+
+// WithClusterBy adds the expressions of the clustering key to the TableBuilder
+func (tb *TableBuilder) WithClusterBy(c []string) *TableBuilder {
+	tb.clusterBy = c
+	return tb
+}
+
+// WithDataRetentionDays adds the days to retain data to the TableBuilder (must
+// be 0-1 for standard edition, 0-90 for enterprise edition)
+func (tb *TableBuilder) WithDataRetentionDays(d int) *TableBuilder {
+	tb.setDataRetentionDays = true
+	tb.dataRetentionDays = d
+	return tb
+}
+
+// WithChangeTracking enables change tracking on the table
+func (tb *TableBuilder) WithChangeTracking(c bool) *TableBuilder {
+	tb.changeTracking = c
+	return tb
+}
+
 // WithColumns sets the column definitions on the TableBuilder
 func (tb *TableBuilder) WithColumns(c Columns) *TableBuilder {
 	tb.columns = c
@@ -145,8 +257,24 @@ func TableWithColumnDefinitions(name, db, schema string, columns Columns) *Table
 // Create returns the SQL statement required to create a table
 func (tb *TableBuilder) Create() string {
 	q := strings.Builder{}
-	q.WriteString(fmt.Sprintf(`CREATE TABLE %v`, tb.QualifiedName()))
-	q.WriteString(tb.columns.getColumnDefinitions())
+	q.WriteString(`CREATE`)
+	if tb.transient {
+		q.WriteString(` TRANSIENT`)
+	}
+	q.WriteString(fmt.Sprintf(` TABLE %v`, tb.QualifiedName()))
+	q.WriteString(tb.columns.getColumnDefinitions(tb.constraints...))
+
+	if len(tb.clusterBy) > 0 {
+		q.WriteString(fmt.Sprintf(` CLUSTER BY (%v)`, strings.Join(tb.clusterBy, ", ")))
+	}
+
+	if tb.setDataRetentionDays {
+		q.WriteString(fmt.Sprintf(` DATA_RETENTION_TIME_IN_DAYS = %d`, tb.dataRetentionDays))
+	}
+
+	if tb.changeTracking {
+		q.WriteString(` CHANGE_TRACKING = TRUE`)
+	}
 
 	if tb.comment != "" {
 		q.WriteString(fmt.Sprintf(` COMMENT = '%v'`, EscapeString(tb.comment)))
@@ -155,6 +283,93 @@ func (tb *TableBuilder) Create() string {
 	return q.String()
 }
 
+// TableCloneBuilder is a builder that creates tables from a source table,
+// either cloning its data or only copying its definition
+type TableCloneBuilder struct {
+	table      *TableBuilder
+	source     *TableBuilder
+	like       bool
+	transient  bool
+	timeTravel string
+}
+
+// Points in time a clone can travel to
+const (
+	TimeTravelTimestamp = "TIMESTAMP"
+	TimeTravelOffset    = "OFFSET"
+	TimeTravelStatement = "STATEMENT"
+)
+
+// TableFromTable returns a pointer to a builder that can create the table
+// name as a clone of the table source
+func TableFromTable(name, db, schema string, source *TableBuilder) *TableCloneBuilder {
+	return &TableCloneBuilder{
+		table:  Table(name, db, schema),
+		source: source,
+	}
+}
+
+// TableLikeTable returns a pointer to a builder that can create the empty table
+// name with the same columns as the table source
+func TableLikeTable(name, db, schema string, source *TableBuilder) *TableCloneBuilder {
+	return &TableCloneBuilder{
+		table:  Table(name, db, schema),
+		source: source,
+		like:   true,
+	}
+}
+
+// Transient adds the TRANSIENT flag to the TableCloneBuilder
+func (tcb *TableCloneBuilder) Transient() *TableCloneBuilder {
+	tcb.transient = true
+	return tcb
+}
+
+// At clones the source as it was at the given point: a timestamp, an offset
+// in seconds from now or the ID of a statement.
+func (tcb *TableCloneBuilder) At(point, value string) *TableCloneBuilder {
+	tcb.timeTravel = fmt.Sprintf(` AT(%v)`, timeTravelPoint(point, value))
+	return tcb
+}
+
+// Before clones the source as it was right before the given point.
+func (tcb *TableCloneBuilder) Before(point, value string) *TableCloneBuilder {
+	tcb.timeTravel = fmt.Sprintf(` BEFORE(%v)`, timeTravelPoint(point, value))
+	return tcb
+}
+
+func timeTravelPoint(point, value string) string {
+	switch point {
+	case TimeTravelTimestamp:
+		return fmt.Sprintf(`TIMESTAMP => '%v'::TIMESTAMP_TZ`, EscapeString(value))
+	case TimeTravelOffset:
+		return fmt.Sprintf(`OFFSET => %v`, value)
+	}
+	return fmt.Sprintf(`%v => '%v'`, point, EscapeString(value))
+}
+
+// Create returns the SQL statement required to create a table from the source table
+func (tcb *TableCloneBuilder) Create() string {
+	q := strings.Builder{}
+	q.WriteString(`CREATE`)
+	if tcb.transient {
+		q.WriteString(` TRANSIENT`)
+	}
+	q.WriteString(fmt.Sprintf(` TABLE %v`, tcb.table.QualifiedName()))
+
+	if tcb.like {
+		q.WriteString(fmt.Sprintf(` LIKE %v`, tcb.source.QualifiedName()))
+		return q.String()
+	}
+	q.WriteString(fmt.Sprintf(` CLONE %v%v`, tcb.source.QualifiedName(), tcb.timeTravel))
+	return q.String()
+}
+
+// Rename returns the SQL query that will rename the table.
+func (tb *TableBuilder) Rename(newName string) string {
+	return fmt.Sprintf(`ALTER TABLE %v RENAME TO %v`, tb.QualifiedName(), Table(newName, tb.db, tb.schema).QualifiedName())
+}
+
 // ChangeComment returns the SQL query that will update the comment on the table.
 func (tb *TableBuilder) ChangeComment(c string) string {
 	return fmt.Sprintf(`ALTER TABLE %v SET COMMENT = '%v'`, tb.QualifiedName(), EscapeString(c))
@@ -165,11 +380,35 @@ func (tb *TableBuilder) RemoveComment() string {
 	return fmt.Sprintf(`ALTER TABLE %v UNSET COMMENT`, tb.QualifiedName())
 }
 
+// ChangeClusterBy returns the SQL query that will set the clustering key of the
+// table, or drop it when c is empty.
+func (tb *TableBuilder) ChangeClusterBy(c []string) string {
+	if len(c) == 0 {
+		return fmt.Sprintf(`ALTER TABLE %v DROP CLUSTERING KEY`, tb.QualifiedName())
+	}
+	return fmt.Sprintf(`ALTER TABLE %v CLUSTER BY (%v)`, tb.QualifiedName(), strings.Join(c, ", "))
+}
+
+// ChangeDataRetentionDays returns the SQL query that will update the data retention days on the table.
+func (tb *TableBuilder) ChangeDataRetentionDays(d int) string {
+	return fmt.Sprintf(`ALTER TABLE %v SET DATA_RETENTION_TIME_IN_DAYS = %d`, tb.QualifiedName(), d)
+}
+
+// ChangeChangeTracking returns the SQL query that will enable or disable change tracking on the table.
+func (tb *TableBuilder) ChangeChangeTracking(c bool) string {
+	return fmt.Sprintf(`ALTER TABLE %v SET CHANGE_TRACKING = %v`, tb.QualifiedName(), strings.ToUpper(strconv.FormatBool(c)))
+}
+
 // Drop returns the SQL query that will drop a table.
 func (tb *TableBuilder) Drop() string {
 	return fmt.Sprintf(`DROP TABLE %v`, tb.QualifiedName())
 }
 
+// Undrop returns the SQL query that will undrop a table.
+func (tb *TableBuilder) Undrop() string {
+	return fmt.Sprintf(`UNDROP TABLE %v`, tb.QualifiedName())
+}
+
 // Show returns the SQL query that will show a table.
 func (tb *TableBuilder) Show() string {
 	return fmt.Sprintf(`SHOW TABLES LIKE '%v' IN SCHEMA "%v"."%v"`, tb.name, tb.db, tb.schema)
@@ -179,6 +418,55 @@ func (tb *TableBuilder) ShowColumns() string {
 	return fmt.Sprintf(`DESC TABLE %s`, tb.QualifiedName())
 }
 
+// AddColumn returns the SQL query that will add column c to the table.
+func (tb *TableBuilder) AddColumn(c Column) string {
+	return fmt.Sprintf(`ALTER TABLE %v ADD COLUMN %v`, tb.QualifiedName(), c.getColumnDefinition())
+}
+
+// DropColumn returns the SQL query that will drop the column name from the table.
+func (tb *TableBuilder) DropColumn(name string) string {
+	return fmt.Sprintf(`ALTER TABLE %v DROP COLUMN "%v"`, tb.QualifiedName(), EscapeString(name))
+}
+
+// RenameColumn returns the SQL query that will rename the column oldName to newName.
+func (tb *TableBuilder) RenameColumn(oldName, newName string) string {
+	return fmt.Sprintf(`ALTER TABLE %v RENAME COLUMN "%v" TO "%v"`, tb.QualifiedName(), EscapeString(oldName), EscapeString(newName))
+}
+
+// ChangeColumnType returns the SQL query that will change the data type of the column name.
+func (tb *TableBuilder) ChangeColumnType(name, t string) string {
+	return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" SET DATA TYPE %v`, tb.QualifiedName(), EscapeString(name), EscapeString(t))
+}
+
+// ChangeColumnNullable returns the SQL query that will add or remove the NOT
+// NULL constraint of the column name.
+func (tb *TableBuilder) ChangeColumnNullable(name string, nullable bool) string {
+	action := "SET"
+	if nullable {
+		action = "DROP"
+	}
+	return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" %v NOT NULL`, tb.QualifiedName(), EscapeString(name), action)
+}
+
+// ChangeColumnDefault returns the SQL query that will change the default of the
+// column name, or drop it when d is empty. Snowflake only supports setting a
+// sequence's NEXTVAL as the new default of an existing column.
+func (tb *TableBuilder) ChangeColumnDefault(name, d string) string {
+	if d == "" {
+		return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" DROP DEFAULT`, tb.QualifiedName(), EscapeString(name))
+	}
+	return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" SET DEFAULT %v`, tb.QualifiedName(), EscapeString(name), d)
+}
+
+// ChangeColumnComment returns the SQL query that will update the comment of the
+// column name, or remove it when c is empty.
+func (tb *TableBuilder) ChangeColumnComment(name, c string) string {
+	if c == "" {
+		return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" UNSET COMMENT`, tb.QualifiedName(), EscapeString(name))
+	}
+	return fmt.Sprintf(`ALTER TABLE %v ALTER COLUMN "%v" COMMENT '%v'`, tb.QualifiedName(), EscapeString(name), EscapeString(c))
+}
+
 type table struct {
 	CreatedOn           sql.NullString `db:"created_on"`
 	TableName           sql.NullString `db:"name"`
@@ -195,16 +483,57 @@ type table struct {
 	ChangeTracking      sql.NullString `db:"change_tracking"`
 }
 
+// GetClusterBy returns the expressions of the table's clustering key, which
+// SHOW TABLES reports as e.g. LINEAR(a, SUBSTRING(b, 1, 2)).
+func (t *table) GetClusterBy() []string {
+	keys := []string{}
+	c := strings.TrimSpace(t.ClusterBy.String)
+	if !strings.HasPrefix(c, "LINEAR(") || !strings.HasSuffix(c, ")") {
+		return keys
+	}
+	c = c[len("LINEAR(") : len(c)-1]
+
+	// split on the top level commas only, expressions may be function calls
+	depth, start := 0, 0
+	for i, r := range c {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				keys = append(keys, strings.TrimSpace(c[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(c[start:]); last != "" {
+		keys = append(keys, last)
+	}
+	return keys
+}
+
 func ScanTable(row *sqlx.Row) (*table, error) {
 	t := &table{}
 	e := row.StructScan(t)
 	return t, e
 }
 
+// ScanTables turns the rows of a SHOW TABLES query into tables
+func ScanTables(rows *sqlx.Rows) ([]table, error) {
+	tables := []table{}
+	err := sqlx.StructScan(rows, &tables)
+	return tables, err
+}
+
 type tableDescription struct {
-	Name sql.NullString `db:"name"`
-	Type sql.NullString `db:"type"`
-	Kind sql.NullString `db:"kind"`
+	Name    sql.NullString `db:"name"`
+	Type    sql.NullString `db:"type"`
+	Kind    sql.NullString `db:"kind"`
+	Null    sql.NullString `db:"null?"`
+	Default sql.NullString `db:"default"`
+	Comment sql.NullString `db:"comment"`
 }
 
 func ScanTableDescription(rows *sqlx.Rows) ([]tableDescription, error) {
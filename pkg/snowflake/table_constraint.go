@@ -0,0 +1,193 @@
+package snowflake
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Kinds of the constraints that can be declared on a table. Snowflake doesn't
+// enforce them, but they are available to tools inferring the joins.
+const (
+	PrimaryKeyConstraint = "PRIMARY KEY"
+	UniqueKeyConstraint  = "UNIQUE"
+	ForeignKeyConstraint = "FOREIGN KEY"
+)
+
+// TableConstraint is an out of line constraint on the columns of a table
+type TableConstraint struct {
+	name    string
+	kind    string
+	columns []string
+
+	// only set for foreign keys
+	references        *TableBuilder
+	referencedColumns []string
+}
+
+// PrimaryKey returns the primary key constraint name on columns
+func PrimaryKey(name string, columns []string) TableConstraint {
+	return TableConstraint{name: name, kind: PrimaryKeyConstraint, columns: columns}
+}
+
+// UniqueKey returns the unique constraint name on columns
+func UniqueKey(name string, columns []string) TableConstraint {
+	return TableConstraint{name: name, kind: UniqueKeyConstraint, columns: columns}
+}
+
+// ForeignKey returns the foreign key constraint name on columns referencing the
+// referencedColumns of the table references
+func ForeignKey(name string, columns []string, references *TableBuilder, referencedColumns []string) TableConstraint {
+	return TableConstraint{
+		name:              name,
+		kind:              ForeignKeyConstraint,
+		columns:           columns,
+		references:        references,
+		referencedColumns: referencedColumns,
+	}
+}
+
+// quoteIdentifiers returns the comma separated list of the quoted identifiers
+func quoteIdentifiers(identifiers []string) string {
+	quoted := make([]string, 0, len(identifiers))
+	for _, i := range identifiers {
+		quoted = append(quoted, fmt.Sprintf(`"%v"`, EscapeString(i)))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func (c TableConstraint) getConstraintDefinition() string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CONSTRAINT "%v" %v (%v)`, EscapeString(c.name), c.kind, quoteIdentifiers(c.columns)))
+	if c.kind == ForeignKeyConstraint {
+		q.WriteString(fmt.Sprintf(` REFERENCES %v (%v)`, c.references.QualifiedName(), quoteIdentifiers(c.referencedColumns)))
+	}
+	return q.String()
+}
+
+// WithConstraints sets the constraints declared when creating the table
+func (tb *TableBuilder) WithConstraints(c []TableConstraint) *TableBuilder {
+	tb.constraints = c
+	return tb
+}
+
+// AddConstraint returns the SQL query that will add constraint c to the table.
+func (tb *TableBuilder) AddConstraint(c TableConstraint) string {
+	return fmt.Sprintf(`ALTER TABLE %v ADD %v`, tb.QualifiedName(), c.getConstraintDefinition())
+}
+
+// DropConstraint returns the SQL query that will drop the constraint name from the table.
+func (tb *TableBuilder) DropConstraint(name string) string {
+	return fmt.Sprintf(`ALTER TABLE %v DROP CONSTRAINT "%v"`, tb.QualifiedName(), EscapeString(name))
+}
+
+// ShowPrimaryKeys returns the SQL query that will list the columns of the table's primary key.
+func (tb *TableBuilder) ShowPrimaryKeys() string {
+	return Show("PRIMARY KEYS").In("TABLE", tb.QualifiedName()).Statement()
+}
+
+// ShowUniqueKeys returns the SQL query that will list the columns of the table's unique constraints.
+func (tb *TableBuilder) ShowUniqueKeys() string {
+	return Show("UNIQUE KEYS").In("TABLE", tb.QualifiedName()).Statement()
+}
+
+// ShowImportedKeys returns the SQL query that will list the columns of the table's foreign keys.
+func (tb *TableBuilder) ShowImportedKeys() string {
+	return Show("IMPORTED KEYS").In("TABLE", tb.QualifiedName()).Statement()
+}
+
+// tableKey is a row of SHOW PRIMARY KEYS or SHOW UNIQUE KEYS, one per column
+// of a constraint
+type tableKey struct {
+	ConstraintName string `db:"constraint_name"`
+	ColumnName     string `db:"column_name"`
+	KeySequence    int    `db:"key_sequence"`
+}
+
+// importedKey is a row of SHOW IMPORTED KEYS, one per column of a foreign key
+type importedKey struct {
+	FkName         string `db:"fk_name"`
+	FkColumnName   string `db:"fk_column_name"`
+	PkDatabaseName string `db:"pk_database_name"`
+	PkSchemaName   string `db:"pk_schema_name"`
+	PkTableName    string `db:"pk_table_name"`
+	PkColumnName   string `db:"pk_column_name"`
+	KeySequence    int    `db:"key_sequence"`
+}
+
+// ScanTableKeys turns the rows of a SHOW PRIMARY KEYS or SHOW UNIQUE KEYS
+// query into constraints of the given kind
+func ScanTableKeys(rows *sqlx.Rows, kind string) (TableConstraints, error) {
+	keys := []tableKey{}
+	err := sqlx.StructScan(rows, &keys)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].KeySequence < keys[j].KeySequence })
+
+	constraints := TableConstraints{}
+	index := map[string]int{}
+	for _, k := range keys {
+		i, ok := index[k.ConstraintName]
+		if !ok {
+			i = len(constraints)
+			index[k.ConstraintName] = i
+			constraints = append(constraints, TableConstraint{name: k.ConstraintName, kind: kind})
+		}
+		constraints[i].columns = append(constraints[i].columns, k.ColumnName)
+	}
+	return constraints, nil
+}
+
+// ScanImportedKeys turns the rows of a SHOW IMPORTED KEYS query into foreign
+// key constraints
+func ScanImportedKeys(rows *sqlx.Rows) (TableConstraints, error) {
+	keys := []importedKey{}
+	err := sqlx.StructScan(rows, &keys)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].KeySequence < keys[j].KeySequence })
+
+	constraints := TableConstraints{}
+	index := map[string]int{}
+	for _, k := range keys {
+		i, ok := index[k.FkName]
+		if !ok {
+			i = len(constraints)
+			index[k.FkName] = i
+			references := Table(k.PkTableName, k.PkDatabaseName, k.PkSchemaName)
+			constraints = append(constraints, ForeignKey(k.FkName, nil, references, nil))
+		}
+		constraints[i].columns = append(constraints[i].columns, k.FkColumnName)
+		constraints[i].referencedColumns = append(constraints[i].referencedColumns, k.PkColumnName)
+	}
+	return constraints, nil
+}
+
+type TableConstraints []TableConstraint
+
+// Flatten returns the constraints as the primary_key, unique_key and
+// foreign_key blocks of the table resource
+func (c TableConstraints) Flatten() []interface{} {
+	flattened := []interface{}{}
+	for _, constraint := range c {
+		flat := map[string]interface{}{}
+		flat["name"] = constraint.name
+		flat["keys"] = constraint.columns
+		if constraint.kind == ForeignKeyConstraint {
+			flat["references"] = []interface{}{
+				map[string]interface{}{
+					"database": constraint.references.db,
+					"schema":   constraint.references.schema,
+					"table":    constraint.references.name,
+					"keys":     constraint.referencedColumns,
+				},
+			}
+		}
+		flattened = append(flattened, flat)
+	}
+	return flattened
+}
@@ -0,0 +1,98 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	. "github.com/chanzuckerberg/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableCreateConstraints(t *testing.T) {
+	r := require.New(t)
+	id := Column{}
+	id.WithName("id").WithType("NUMBER")
+	parentID := Column{}
+	parentID.WithName("parent_id").WithType("NUMBER")
+
+	s := TableWithColumnDefinitions("test_table", "test_db", "test_schema", Columns{id, parentID})
+	s.WithConstraints([]TableConstraint{
+		PrimaryKey("pk", []string{"id"}),
+		ForeignKey("fk_parent", []string{"parent_id"}, Table("parent", "test_db", "test_schema"), []string{"id"}),
+	})
+	r.Equal(`CREATE TABLE "test_db"."test_schema"."test_table" ("id" NUMBER, "parent_id" NUMBER, CONSTRAINT "pk" PRIMARY KEY ("id"), CONSTRAINT "fk_parent" FOREIGN KEY ("parent_id") REFERENCES "test_db"."test_schema"."parent" ("id"))`, s.Create())
+}
+
+func TestTableChangeConstraints(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ADD CONSTRAINT "pk" PRIMARY KEY ("a", "b")`, s.AddConstraint(PrimaryKey("pk", []string{"a", "b"})))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ADD CONSTRAINT "uk" UNIQUE ("c")`, s.AddConstraint(UniqueKey("uk", []string{"c"})))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" DROP CONSTRAINT "uk"`, s.DropConstraint("uk"))
+}
+
+func TestTableShowConstraints(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+	r.Equal(`SHOW PRIMARY KEYS IN TABLE "test_db"."test_schema"."test_table"`, s.ShowPrimaryKeys())
+	r.Equal(`SHOW UNIQUE KEYS IN TABLE "test_db"."test_schema"."test_table"`, s.ShowUniqueKeys())
+	r.Equal(`SHOW IMPORTED KEYS IN TABLE "test_db"."test_schema"."test_table"`, s.ShowImportedKeys())
+}
+
+func TestTableConstraintsFlatten(t *testing.T) {
+	r := require.New(t)
+	c := TableConstraints{
+		UniqueKey("uk", []string{"a", "b"}),
+		ForeignKey("fk", []string{"c"}, Table("parent", "test_db", "test_schema"), []string{"id"}),
+	}
+	r.Equal([]interface{}{
+		map[string]interface{}{"name": "uk", "keys": []string{"a", "b"}},
+		map[string]interface{}{
+			"name": "fk",
+			"keys": []string{"c"},
+			"references": []interface{}{
+				map[string]interface{}{"database": "test_db", "schema": "test_schema", "table": "parent", "keys": []string{"id"}},
+			},
+		},
+	}, c.Flatten())
+}
+
+func TestScanTableKeys(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// the columns of a key are ordered by key_sequence
+		rows := sqlmock.NewRows([]string{"created_on", "database_name", "schema_name", "table_name", "column_name", "key_sequence", "constraint_name", "rely", "comment"}).
+			AddRow("_", "test_db", "test_schema", "test_table", "b", 2, "uk_ab", "false", nil).
+			AddRow("_", "test_db", "test_schema", "test_table", "a", 1, "uk_ab", "false", nil).
+			AddRow("_", "test_db", "test_schema", "test_table", "c", 1, "uk_c", "false", nil)
+		mock.ExpectQuery(`^SHOW UNIQUE KEYS`).WillReturnRows(rows)
+
+		result, err := Query(context.Background(), db, Table("test_table", "test_db", "test_schema").ShowUniqueKeys())
+		r.NoError(err)
+		keys, err := ScanTableKeys(result, UniqueKeyConstraint)
+		r.NoError(err)
+		r.Equal(TableConstraints{UniqueKey("uk_ab", []string{"a", "b"}), UniqueKey("uk_c", []string{"c"})}, keys)
+	})
+}
+
+func TestScanImportedKeys(t *testing.T) {
+	r := require.New(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "pk_database_name", "pk_schema_name", "pk_table_name", "pk_column_name", "fk_database_name", "fk_schema_name", "fk_table_name", "fk_column_name", "key_sequence", "update_rule", "delete_rule", "fk_name", "pk_name", "deferrability", "rely", "comment"}).
+			AddRow("_", "test_db", "test_schema", "parent", "id2", "test_db", "test_schema", "test_table", "parent_id2", 2, "NO ACTION", "NO ACTION", "fk", "pk", "NOT DEFERRABLE", "false", nil).
+			AddRow("_", "test_db", "test_schema", "parent", "id1", "test_db", "test_schema", "test_table", "parent_id1", 1, "NO ACTION", "NO ACTION", "fk", "pk", "NOT DEFERRABLE", "false", nil)
+		mock.ExpectQuery(`^SHOW IMPORTED KEYS`).WillReturnRows(rows)
+
+		result, err := Query(context.Background(), db, Table("test_table", "test_db", "test_schema").ShowImportedKeys())
+		r.NoError(err)
+		keys, err := ScanImportedKeys(result)
+		r.NoError(err)
+		r.Equal(TableConstraints{
+			ForeignKey("fk", []string{"parent_id1", "parent_id2"}, Table("parent", "test_db", "test_schema"), []string{"id1", "id2"}),
+		}, keys)
+	})
+}
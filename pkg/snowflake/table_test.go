@@ -1,6 +1,7 @@
 package snowflake
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,40 @@ func TestTableCreate(t *testing.T) {
 	r.Equal(s.Create(), `CREATE TABLE "test_db"."test_schema"."test_table" ("column1" OBJECT, "column2" VARCHAR) COMMENT = 'Test Comment'`)
 }
 
+func TestTableCreateProperties(t *testing.T) {
+	r := require.New(t)
+	c := Column{}
+	c.WithName("column1").WithType("DATE")
+	s := TableWithColumnDefinitions("test_table", "test_db", "test_schema", Columns{c})
+
+	s.WithClusterBy([]string{"column1", "SUBSTRING(column2, 1, 2)"}).WithDataRetentionDays(0).WithChangeTracking(true)
+	r.Equal(`CREATE TABLE "test_db"."test_schema"."test_table" ("column1" DATE) CLUSTER BY (column1, SUBSTRING(column2, 1, 2)) DATA_RETENTION_TIME_IN_DAYS = 0 CHANGE_TRACKING = TRUE`, s.Create())
+}
+
+func TestTableChangeProperties(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" CLUSTER BY (column1, column2)`, s.ChangeClusterBy([]string{"column1", "column2"}))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" DROP CLUSTERING KEY`, s.ChangeClusterBy(nil))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" SET DATA_RETENTION_TIME_IN_DAYS = 30`, s.ChangeDataRetentionDays(30))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" SET CHANGE_TRACKING = FALSE`, s.ChangeChangeTracking(false))
+}
+
+func TestTableGetClusterBy(t *testing.T) {
+	r := require.New(t)
+	tbl := &table{ClusterBy: sql.NullString{String: "LINEAR(a, SUBSTRING(b, 1, 2), TO_DATE(c))", Valid: true}}
+	r.Equal([]string{"a", "SUBSTRING(b, 1, 2)", "TO_DATE(c)"}, tbl.GetClusterBy())
+
+	tbl = &table{}
+	r.Equal([]string{}, tbl.GetClusterBy())
+}
+
+func TestTableRename(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" RENAME TO "test_db"."test_schema"."test_table2"`, s.Rename("test_table2"))
+}
+
 func TestTableChangeComment(t *testing.T) {
 	r := require.New(t)
 	s := Table("test_table", "test_db", "test_schema")
@@ -47,8 +82,114 @@ func TestTableDrop(t *testing.T) {
 	r.Equal(s.Drop(), `DROP TABLE "test_db"."test_schema"."test_table"`)
 }
 
+func TestTableUndrop(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+	r.Equal(s.Undrop(), `UNDROP TABLE "test_db"."test_schema"."test_table"`)
+}
+
 func TestTableShow(t *testing.T) {
 	r := require.New(t)
 	s := Table("test_table", "test_db", "test_schema")
 	r.Equal(s.Show(), `SHOW TABLES LIKE 'test_table' IN SCHEMA "test_db"."test_schema"`)
 }
+
+func TestTableCreateColumnOptions(t *testing.T) {
+	r := require.New(t)
+	id := Column{}
+	id.WithName("id").WithType("NUMBER(38,0)").WithIdentity(1, 1).WithNullable(false)
+	ts := Column{}
+	ts.WithName("loaded_at").WithType("TIMESTAMP_NTZ").WithDefault("CURRENT_TIMESTAMP()").WithComment("load time")
+
+	s := TableWithColumnDefinitions("test_table", "test_db", "test_schema", Columns{id, ts})
+	r.Equal(`CREATE TABLE "test_db"."test_schema"."test_table" ("id" NUMBER(38,0) IDENTITY(1, 1) NOT NULL, "loaded_at" TIMESTAMP_NTZ DEFAULT CURRENT_TIMESTAMP() COMMENT 'load time')`, s.Create())
+}
+
+func TestTableChangeColumns(t *testing.T) {
+	r := require.New(t)
+	s := Table("test_table", "test_db", "test_schema")
+
+	c := Column{}
+	c.WithName("column3").WithType("VARCHAR").WithNullable(false).WithComment("third")
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ADD COLUMN "column3" VARCHAR NOT NULL COMMENT 'third'`, s.AddColumn(c))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" DROP COLUMN "column3"`, s.DropColumn("column3"))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" RENAME COLUMN "column3" TO "column4"`, s.RenameColumn("column3", "column4"))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ALTER COLUMN "column3" SET DATA TYPE VARCHAR(50)`, s.ChangeColumnType("column3", "VARCHAR(50)"))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ALTER COLUMN "column3" SET NOT NULL`, s.ChangeColumnNullable("column3", false))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ALTER COLUMN "column3" DROP NOT NULL`, s.ChangeColumnNullable("column3", true))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ALTER COLUMN "column3" SET DEFAULT "test_db"."test_schema"."seq".NEXTVAL`, s.ChangeColumnDefault("column3", `"test_db"."test_schema"."seq".NEXTVAL`))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ALTER COLUMN "column3" DROP DEFAULT`, s.ChangeColumnDefault("column3", ""))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ALTER COLUMN "column3" COMMENT 'new comment'`, s.ChangeColumnComment("column3", "new comment"))
+	r.Equal(`ALTER TABLE "test_db"."test_schema"."test_table" ALTER COLUMN "column3" UNSET COMMENT`, s.ChangeColumnComment("column3", ""))
+}
+
+func TestNewColumns(t *testing.T) {
+	r := require.New(t)
+	tds := []tableDescription{
+		{
+			Name:    sql.NullString{String: "id", Valid: true},
+			Type:    sql.NullString{String: "NUMBER(38,0)", Valid: true},
+			Kind:    sql.NullString{String: "COLUMN", Valid: true},
+			Null:    sql.NullString{String: "N", Valid: true},
+			Default: sql.NullString{String: "IDENTITY START 10 INCREMENT 5", Valid: true},
+		},
+		{
+			Name:    sql.NullString{String: "status", Valid: true},
+			Type:    sql.NullString{String: "VARCHAR(16777216)", Valid: true},
+			Kind:    sql.NullString{String: "COLUMN", Valid: true},
+			Null:    sql.NullString{String: "Y", Valid: true},
+			Default: sql.NullString{String: "'new'", Valid: true},
+			Comment: sql.NullString{String: "lifecycle", Valid: true},
+		},
+	}
+
+	r.Equal([]interface{}{
+		map[string]interface{}{
+			"name":     "id",
+			"type":     "NUMBER(38,0)",
+			"nullable": false,
+			"default":  "",
+			"comment":  "",
+			"identity": []interface{}{map[string]interface{}{"start_num": 10, "step_num": 5}},
+		},
+		map[string]interface{}{
+			"name":     "status",
+			"type":     "VARCHAR(16777216)",
+			"nullable": true,
+			"default":  "'new'",
+			"comment":  "lifecycle",
+			"identity": []interface{}{},
+		},
+	}, NewColumns(tds).Flatten())
+}
+
+func TestTableCreateTransient(t *testing.T) {
+	r := require.New(t)
+	c := Column{}
+	c.WithName("column1").WithType("VARIANT")
+	s := TableWithColumnDefinitions("test_table", "test_db", "test_schema", Columns{c}).Transient()
+	r.Equal(`CREATE TRANSIENT TABLE "test_db"."test_schema"."test_table" ("column1" VARIANT)`, s.Create())
+}
+
+func TestTableFromTable(t *testing.T) {
+	r := require.New(t)
+	source := Table("source_table", "test_db", "test_schema")
+
+	s := TableFromTable("test_table", "test_db", "dev", source)
+	r.Equal(`CREATE TABLE "test_db"."dev"."test_table" CLONE "test_db"."test_schema"."source_table"`, s.Create())
+
+	s.At(TimeTravelTimestamp, "2021-01-01 00:00:00 +0000")
+	r.Equal(`CREATE TABLE "test_db"."dev"."test_table" CLONE "test_db"."test_schema"."source_table" AT(TIMESTAMP => '2021-01-01 00:00:00 +0000'::TIMESTAMP_TZ)`, s.Create())
+
+	s.At(TimeTravelOffset, "-3600")
+	r.Equal(`CREATE TABLE "test_db"."dev"."test_table" CLONE "test_db"."test_schema"."source_table" AT(OFFSET => -3600)`, s.Create())
+
+	s.Before(TimeTravelStatement, "8e5d0ca9-005e-44e6-b858-a8f5b37c5726").Transient()
+	r.Equal(`CREATE TRANSIENT TABLE "test_db"."dev"."test_table" CLONE "test_db"."test_schema"."source_table" BEFORE(STATEMENT => '8e5d0ca9-005e-44e6-b858-a8f5b37c5726')`, s.Create())
+}
+
+func TestTableLikeTable(t *testing.T) {
+	r := require.New(t)
+	s := TableLikeTable("test_table", "test_db", "staging", Table("source_table", "test_db", "test_schema"))
+	r.Equal(`CREATE TABLE "test_db"."staging"."test_table" LIKE "test_db"."test_schema"."source_table"`, s.Create())
+}
@@ -33,3 +33,10 @@ func ScanUser(row *sqlx.Row) (*user, error) {
 	err := row.StructScan(r)
 	return r, err
 }
+
+// ScanUsers turns the rows of a SHOW USERS query into users
+func ScanUsers(rows *sqlx.Rows) ([]user, error) {
+	users := []user{}
+	err := sqlx.StructScan(rows, &users)
+	return users, err
+}
@@ -183,9 +183,11 @@ func (vb *ViewBuilder) Drop() (string, error) {
 }
 
 type view struct {
+	CreatedOn    sql.NullString `db:"created_on"`
 	Comment      sql.NullString `db:"comment"`
 	IsSecure     bool           `db:"is_secure"`
 	Name         sql.NullString `db:"name"`
+	Owner        sql.NullString `db:"owner"`
 	SchemaName   sql.NullString `db:"schema_name"`
 	Text         sql.NullString `db:"text"`
 	DatabaseName sql.NullString `db:"database_name"`
@@ -196,3 +198,10 @@ func ScanView(row *sqlx.Row) (*view, error) {
 	err := row.StructScan(r)
 	return r, err
 }
+
+// ScanViews turns the rows of a SHOW VIEWS query into views
+func ScanViews(rows *sqlx.Rows) ([]view, error) {
+	views := []view{}
+	err := sqlx.StructScan(rows, &views)
+	return views, err
+}
@@ -45,6 +45,9 @@ type warehouse struct {
 	Suspended       int64     `db:"suspended"`
 	UUID            string    `db:"uuid"`
 	ScalingPolicy   string    `db:"scaling_policy"`
+
+	EnableQueryAcceleration         bool  `db:"enable_query_acceleration"`
+	QueryAccelerationMaxScaleFactor int64 `db:"query_acceleration_max_scale_factor"`
 }
 
 func ScanWarehouse(row *sqlx.Row) (*warehouse, error) {
@@ -52,3 +55,10 @@ func ScanWarehouse(row *sqlx.Row) (*warehouse, error) {
 	err := row.StructScan(w)
 	return w, err
 }
+
+// ScanWarehouses turns the rows of a SHOW WAREHOUSES query into warehouses
+func ScanWarehouses(rows *sqlx.Rows) ([]warehouse, error) {
+	warehouses := []warehouse{}
+	err := sqlx.StructScan(rows, &warehouses)
+	return warehouses, err
+}
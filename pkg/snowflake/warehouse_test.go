@@ -0,0 +1,56 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/chanzuckerberg/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouse(t *testing.T) {
+	r := require.New(t)
+	w := snowflake.Warehouse("wh1")
+
+	r.Equal(`SHOW PARAMETERS IN WAREHOUSE "wh1"`, w.ShowParameters())
+	r.Equal(`ALTER WAREHOUSE "wh1" UNSET MAX_CONCURRENCY_LEVEL`, w.Unset("max_concurrency_level"))
+	r.Equal(
+		`ALTER WAREHOUSE "wh1" UNSET MAX_CONCURRENCY_LEVEL, STATEMENT_QUEUED_TIMEOUT_IN_SECONDS`,
+		w.Unset("max_concurrency_level", "statement_queued_timeout_in_seconds"),
+	)
+
+	c := w.Create()
+	c.SetString("warehouse_type", "SNOWPARK-OPTIMIZED")
+	c.SetBool("enable_query_acceleration", true)
+	c.SetInt("query_acceleration_max_scale_factor", 4)
+	r.Equal(
+		`CREATE WAREHOUSE "wh1" WAREHOUSE_TYPE='SNOWPARK-OPTIMIZED' ENABLE_QUERY_ACCELERATION=true QUERY_ACCELERATION_MAX_SCALE_FACTOR=4`,
+		c.Statement(),
+	)
+}
+
+func TestScanParameters(t *testing.T) {
+	r := require.New(t)
+
+	mockDB, mock, err := sqlmock.New()
+	r.NoError(err)
+	defer mockDB.Close()
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description", "type"}).
+		AddRow("MAX_CONCURRENCY_LEVEL", "4", "8", "WAREHOUSE", "desc", "NUMBER").
+		AddRow("STATEMENT_TIMEOUT_IN_SECONDS", "600", "172800", "ACCOUNT", "desc", "NUMBER")
+	mock.ExpectQuery(`SHOW PARAMETERS IN WAREHOUSE "wh1"`).WillReturnRows(rows)
+
+	res, err := sqlxDB.Queryx(snowflake.Warehouse("wh1").ShowParameters())
+	r.NoError(err)
+	params, err := snowflake.ScanParameters(res)
+	r.NoError(err)
+	r.Len(params, 2)
+	r.Equal("MAX_CONCURRENCY_LEVEL", params[0].Key)
+	r.Equal("4", params[0].Value)
+	r.True(params[0].IsSetOn(snowflake.WarehouseType))
+	r.Equal("8", params[0].DefaultValue)
+	r.False(params[1].IsSetOn(snowflake.WarehouseType))
+}